@@ -2,19 +2,15 @@ package playfab
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
-	"strings"
 	"time"
 )
 
-const url = "https://%s.playfabapi.com/%s/%s"
-const retries = 3
-const conflictStatus = "Conflict"
-const ErrUnknown int = 99
-
 type Logger interface {
 	Debug(format string, v ...interface{})
 	Info(format string, v ...interface{})
@@ -22,25 +18,33 @@ type Logger interface {
 	Error(format string, v ...interface{})
 }
 
-type PlayFabError struct {
-	originError  error
-	Body         []byte
-	Method       string
-	RespCode     int
-	ErrorCode    int
-	ErrorMsg     string
-	ErrorDetails string
+type Option func(pf *PlayFab)
+
+func WithLogger(logger Logger) Option {
+	return func(pf *PlayFab) {
+		pf.logger = logger
+	}
 }
 
-func (e *PlayFabError) Error() string {
-	return fmt.Sprintf("%s - %s", e.Method, e.originError.Error())
+// WithHTTPClient makes the client send its requests through hc.
+// WithTimeout and WithTransport are ignored when it is used.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(pf *PlayFab) {
+		pf.hc = hc
+	}
 }
 
-type Option func(pf *PlayFab)
+// WithTimeout sets the timeout of a single HTTP attempt. It defaults to 10 seconds.
+func WithTimeout(timeout time.Duration) Option {
+	return func(pf *PlayFab) {
+		pf.timeout = timeout
+	}
+}
 
-func WithLogger(logger Logger) Option {
+// WithTransport replaces the default pooled http.Transport.
+func WithTransport(transport http.RoundTripper) Option {
 	return func(pf *PlayFab) {
-		pf.logger = logger
+		pf.transport = transport
 	}
 }
 
@@ -50,51 +54,91 @@ type PlayFab struct {
 	catalogVersion string
 	titleId        string
 	hc             *http.Client
+	timeout        time.Duration
+	transport      http.RoundTripper
+	endpoints      EndpointResolver
+	retry          RetryPolicy
+	titleToken     *entityTokenCache
+	dataLocks      *keyedMutex
 }
 
+// New creates a Server API client. catalogVersion is the catalog used by
+// calls that don't name one; when it is empty PlayFab uses the title's
+// primary catalog.
 func New(secret, titleId, catalogVersion string, opts ...Option) (*PlayFab, error) {
 	switch "" {
 	case secret:
 		return nil, fmt.Errorf("secret is required")
-	case catalogVersion:
-		return nil, fmt.Errorf("catalog version is required")
 	case titleId:
 		return nil, fmt.Errorf("titleId is required")
 	}
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxConnsPerHost:     100,
-		MaxIdleConnsPerHost: 100,
-		IdleConnTimeout:     time.Minute * 1,
-	}
+	return newPlayFab(secret, titleId, catalogVersion, opts...), nil
+}
+
+// ForCatalogVersion returns a client that shares pf's credentials, transport
+// and caches but defaults to catalogVersion, for titles running several
+// catalogs side by side.
+func (pf *PlayFab) ForCatalogVersion(catalogVersion string) *PlayFab {
+	c := *pf
+	c.catalogVersion = catalogVersion
+	return &c
+}
+
+func newPlayFab(secret, titleId, catalogVersion string, opts ...Option) *PlayFab {
 	pf := &PlayFab{
 		secret:         secret,
 		catalogVersion: catalogVersion,
 		titleId:        titleId,
 		logger:         &noopLogger{},
-		hc: &http.Client{
-			Transport: transport,
-			Timeout:   time.Second * 10,
-		},
+		retry:          DefaultRetryPolicy(),
+		endpoints:      defaultEndpoints,
+		timeout:        time.Second * 10,
+		dataLocks:      newKeyedMutex(),
 	}
 	for _, opt := range opts {
 		opt(pf)
 	}
-	return pf, nil
+	pf.titleToken = &entityTokenCache{
+		fetch: func(ctx context.Context, _ *EntityTokenResponse) (*EntityTokenResponse, error) {
+			return pf.GetEntityToken(ctx, &GetEntityTokenRequest{})
+		},
+	}
+	if pf.hc == nil {
+		if pf.transport == nil {
+			pf.transport = &http.Transport{
+				MaxIdleConns:        100,
+				MaxConnsPerHost:     100,
+				MaxIdleConnsPerHost: 100,
+				IdleConnTimeout:     time.Minute * 1,
+			}
+		}
+		pf.hc = &http.Client{
+			Transport: pf.transport,
+			Timeout:   pf.timeout,
+		}
+	}
+	return pf
 }
 
 func (pf *PlayFab) EvaluateRandomTable(tableId string, playFabId string) (string, error) {
-	requestBody, err := json.Marshal(map[string]string{
-		"TableId":        tableId,
-		"PlayFabId":      playFabId,
-		"CatalogVersion": pf.catalogVersion,
-	})
+	return pf.EvaluateRandomTableCtx(context.Background(), tableId, playFabId)
+}
+
+func (pf *PlayFab) EvaluateRandomTableCtx(ctx context.Context, tableId string, playFabId string) (string, error) {
+	reqBody := map[string]interface{}{
+		"TableId":   tableId,
+		"PlayFabId": playFabId,
+	}
+	if pf.catalogVersion != "" {
+		reqBody["CatalogVersion"] = pf.catalogVersion
+	}
+	requestBody, err := json.Marshal(reqBody)
 
 	if err != nil {
 		return "", err
 	}
 
-	body, err := pf.request("POST", "Server", "EvaluateRandomResultTable", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "EvaluateRandomResultTable", requestBody)
 
 	if err != nil {
 		return "", err
@@ -123,6 +167,10 @@ func (pf *PlayFab) EvaluateRandomTable(tableId string, playFabId string) (string
 }
 
 func (pf *PlayFab) UpdateUserInternalData(data map[string]string, playFabId string, keysToRemove []string) error {
+	return pf.UpdateUserInternalDataCtx(context.Background(), data, playFabId, keysToRemove)
+}
+
+func (pf *PlayFab) UpdateUserInternalDataCtx(ctx context.Context, data map[string]string, playFabId string, keysToRemove []string) error {
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"Data":         data,
 		"PlayFabId":    playFabId,
@@ -133,7 +181,7 @@ func (pf *PlayFab) UpdateUserInternalData(data map[string]string, playFabId stri
 		return err
 	}
 
-	_, err = pf.request("POST", "Server", "UpdateUserInternalData", requestBody)
+	_, err = pf.request(ctx, "POST", "Server", "UpdateUserInternalData", requestBody)
 
 	if err != nil {
 		return err
@@ -143,6 +191,10 @@ func (pf *PlayFab) UpdateUserInternalData(data map[string]string, playFabId stri
 }
 
 func (pf *PlayFab) GetUserInternalData(keys []string, playFabId string) (map[string]interface{}, error) {
+	return pf.GetUserInternalDataCtx(context.Background(), keys, playFabId)
+}
+
+func (pf *PlayFab) GetUserInternalDataCtx(ctx context.Context, keys []string, playFabId string) (map[string]interface{}, error) {
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"Keys":      keys,
 		"PlayFabId": playFabId,
@@ -152,7 +204,7 @@ func (pf *PlayFab) GetUserInternalData(keys []string, playFabId string) (map[str
 		return nil, err
 	}
 
-	body, err := pf.request("POST", "Server", "GetUserInternalData", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "GetUserInternalData", requestBody)
 
 	if err != nil {
 		return nil, err
@@ -179,6 +231,10 @@ func (pf *PlayFab) GetUserInternalData(keys []string, playFabId string) (map[str
 }
 
 func (pf *PlayFab) UpdateUserReadOnlyData(data map[string]string, playFabId string) error {
+	return pf.UpdateUserReadOnlyDataCtx(context.Background(), data, playFabId)
+}
+
+func (pf *PlayFab) UpdateUserReadOnlyDataCtx(ctx context.Context, data map[string]string, playFabId string) error {
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"Data":      data,
 		"PlayFabId": playFabId,
@@ -188,7 +244,7 @@ func (pf *PlayFab) UpdateUserReadOnlyData(data map[string]string, playFabId stri
 		return err
 	}
 
-	_, err = pf.request("POST", "Server", "UpdateUserReadOnlyData", requestBody)
+	_, err = pf.request(ctx, "POST", "Server", "UpdateUserReadOnlyData", requestBody)
 
 	if err != nil {
 		return err
@@ -198,6 +254,10 @@ func (pf *PlayFab) UpdateUserReadOnlyData(data map[string]string, playFabId stri
 }
 
 func (pf *PlayFab) GetUserReadOnlyData(keys []string, playFabId string) (map[string]interface{}, error) {
+	return pf.GetUserReadOnlyDataCtx(context.Background(), keys, playFabId)
+}
+
+func (pf *PlayFab) GetUserReadOnlyDataCtx(ctx context.Context, keys []string, playFabId string) (map[string]interface{}, error) {
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"Keys":      keys,
 		"PlayFabId": playFabId,
@@ -207,7 +267,7 @@ func (pf *PlayFab) GetUserReadOnlyData(keys []string, playFabId string) (map[str
 		return nil, err
 	}
 
-	body, err := pf.request("POST", "Server", "GetUserReadOnlyData", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "GetUserReadOnlyData", requestBody)
 
 	if err != nil {
 		return nil, err
@@ -234,11 +294,18 @@ func (pf *PlayFab) GetUserReadOnlyData(keys []string, playFabId string) (map[str
 }
 
 func (pf *PlayFab) GrantItemsToUser(itemIds []string, playFabId string) ([]interface{}, error) {
-	requestBody, err := json.Marshal(map[string]interface{}{
-		"ItemIds":        itemIds,
-		"PlayFabId":      playFabId,
-		"CatalogVersion": pf.catalogVersion,
-	})
+	return pf.GrantItemsToUserCtx(context.Background(), itemIds, playFabId)
+}
+
+func (pf *PlayFab) GrantItemsToUserCtx(ctx context.Context, itemIds []string, playFabId string) ([]interface{}, error) {
+	reqBody := map[string]interface{}{
+		"ItemIds":   itemIds,
+		"PlayFabId": playFabId,
+	}
+	if pf.catalogVersion != "" {
+		reqBody["CatalogVersion"] = pf.catalogVersion
+	}
+	requestBody, err := json.Marshal(reqBody)
 
 	pf.logger.Debug("grant items to user playfabId: %s, itemIds %s", playFabId, itemIds)
 
@@ -247,7 +314,7 @@ func (pf *PlayFab) GrantItemsToUser(itemIds []string, playFabId string) ([]inter
 		return nil, err
 	}
 
-	body, err := pf.request("POST", "Server", "GrantItemsToUser", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "GrantItemsToUser", requestBody)
 
 	pf.logger.Debug("grant items response %s", body)
 
@@ -277,43 +344,48 @@ func (pf *PlayFab) GrantItemsToUser(itemIds []string, playFabId string) ([]inter
 }
 
 func (pf *PlayFab) GetPlayerStatistics(statisitcsIds []string, playFabId string) ([]map[string]interface{}, error) {
+	return pf.GetPlayerStatisticsCtx(context.Background(), statisitcsIds, playFabId)
+}
+
+func (pf *PlayFab) GetPlayerStatisticsCtx(ctx context.Context, statisitcsIds []string, playFabId string) ([]map[string]interface{}, error) {
 	pf.logger.Debug("starting ReadPlayerStatistics")
 	requestBody, err := json.Marshal(map[string]interface{}{
-		"PlayFabId":       playFabId,
-		"StatisticsNames": statisitcsIds,
+		"PlayFabId":      playFabId,
+		"StatisticNames": statisitcsIds,
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := pf.request("GET", "Server", "GetPlayerStatistics", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "GetPlayerStatistics", requestBody)
 
 	if err != nil {
 		return nil, err
 	}
 
-	res := make(map[string]interface{})
+	res := struct {
+		Data *struct {
+			Statistics []map[string]interface{}
+		} `json:"data"`
+	}{}
 
 	if err := json.Unmarshal(body, &res); err != nil {
 		return nil, err
 	}
 
-	data, ok := res["data"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("Failed to parse  GetPLayerStatistics")
-	}
-
-	statisitcs, ok := data["Statistics"].([]map[string]interface{})
-
-	if !ok {
-		return nil, fmt.Errorf("Failed to parse  GetPLayerStatistics")
+	if res.Data == nil {
+		return nil, fmt.Errorf("Failed to parse GetPlayerStatistics")
 	}
 
-	return statisitcs, nil
+	return res.Data.Statistics, nil
 }
 
 func (pf *PlayFab) GetPlayerCombinedInfo(reqInfo map[string]interface{}, playFabId string) (map[string]interface{}, error) {
+	return pf.GetPlayerCombinedInfoCtx(context.Background(), reqInfo, playFabId)
+}
+
+func (pf *PlayFab) GetPlayerCombinedInfoCtx(ctx context.Context, reqInfo map[string]interface{}, playFabId string) (map[string]interface{}, error) {
 	pf.logger.Debug("starting getplayercombinedinfo")
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"PlayFabId":             playFabId,
@@ -324,7 +396,7 @@ func (pf *PlayFab) GetPlayerCombinedInfo(reqInfo map[string]interface{}, playFab
 		return nil, err
 	}
 
-	body, err := pf.request("POST", "Server", "GetPlayerCombinedInfo", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "GetPlayerCombinedInfo", requestBody)
 
 	if err != nil {
 		return nil, err
@@ -350,6 +422,10 @@ func (pf *PlayFab) GetPlayerCombinedInfo(reqInfo map[string]interface{}, playFab
 }
 
 func (pf *PlayFab) UpdatePlayerStatistics(statistics []interface{}, playFabId string) error {
+	return pf.UpdatePlayerStatisticsCtx(context.Background(), statistics, playFabId)
+}
+
+func (pf *PlayFab) UpdatePlayerStatisticsCtx(ctx context.Context, statistics []interface{}, playFabId string) error {
 	pf.logger.Debug("starting UpdatePlayerStatistics")
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"PlayFabId":  playFabId,
@@ -360,7 +436,7 @@ func (pf *PlayFab) UpdatePlayerStatistics(statistics []interface{}, playFabId st
 		return err
 	}
 
-	_, err = pf.request("POST", "Server", "UpdatePlayerStatistics", requestBody)
+	_, err = pf.request(ctx, "POST", "Server", "UpdatePlayerStatistics", requestBody)
 
 	if err != nil {
 		return err
@@ -370,6 +446,10 @@ func (pf *PlayFab) UpdatePlayerStatistics(statistics []interface{}, playFabId st
 }
 
 func (pf *PlayFab) GetTitleInternalData(keys []string) (map[string]interface{}, error) {
+	return pf.GetTitleInternalDataCtx(context.Background(), keys)
+}
+
+func (pf *PlayFab) GetTitleInternalDataCtx(ctx context.Context, keys []string) (map[string]interface{}, error) {
 	pf.logger.Debug("starting GetTitleInternalData")
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"Keys": keys,
@@ -379,7 +459,7 @@ func (pf *PlayFab) GetTitleInternalData(keys []string) (map[string]interface{},
 		return nil, err
 	}
 
-	body, err := pf.request("POST", "Server", "GetTitleInternalData", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "GetTitleInternalData", requestBody)
 
 	if err != nil {
 		return nil, err
@@ -402,6 +482,10 @@ func (pf *PlayFab) GetTitleInternalData(keys []string) (map[string]interface{},
 }
 
 func (pf *PlayFab) GetTitleData(keys []string) (map[string]interface{}, error) {
+	return pf.GetTitleDataCtx(context.Background(), keys)
+}
+
+func (pf *PlayFab) GetTitleDataCtx(ctx context.Context, keys []string) (map[string]interface{}, error) {
 	pf.logger.Debug("starting GetTitleData")
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"Keys": keys,
@@ -411,7 +495,7 @@ func (pf *PlayFab) GetTitleData(keys []string) (map[string]interface{}, error) {
 		return nil, err
 	}
 
-	body, err := pf.request("POST", "Server", "GetTitleData", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "GetTitleData", requestBody)
 
 	if err != nil {
 		return nil, err
@@ -434,18 +518,25 @@ func (pf *PlayFab) GetTitleData(keys []string) (map[string]interface{}, error) {
 }
 
 func (pf *PlayFab) GetStoreItems(storeId string, playfabId string) ([]interface{}, string, error) {
+	return pf.GetStoreItemsCtx(context.Background(), storeId, playfabId)
+}
+
+func (pf *PlayFab) GetStoreItemsCtx(ctx context.Context, storeId string, playfabId string) ([]interface{}, string, error) {
 	pf.logger.Debug("starting GetStoreItems")
-	requestBody, err := json.Marshal(map[string]interface{}{
-		"CatalogVersion": pf.catalogVersion,
-		"StoreId":        storeId,
-		"PlayFabId":      playfabId,
-	})
+	reqBody := map[string]interface{}{
+		"StoreId":   storeId,
+		"PlayFabId": playfabId,
+	}
+	if pf.catalogVersion != "" {
+		reqBody["CatalogVersion"] = pf.catalogVersion
+	}
+	requestBody, err := json.Marshal(reqBody)
 
 	if err != nil {
 		return nil, "", err
 	}
 
-	body, err := pf.request("POST", "Server", "GetStoreItems", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "GetStoreItems", requestBody)
 
 	if err != nil {
 		return nil, "", err
@@ -474,17 +565,24 @@ func (pf *PlayFab) GetStoreItems(storeId string, playfabId string) ([]interface{
 }
 
 func (pf *PlayFab) GetStore(storeId string) (map[string]interface{}, error) {
+	return pf.GetStoreCtx(context.Background(), storeId)
+}
+
+func (pf *PlayFab) GetStoreCtx(ctx context.Context, storeId string) (map[string]interface{}, error) {
 	pf.logger.Debug("starting GetStore")
-	requestBody, err := json.Marshal(map[string]interface{}{
-		"CatalogVersion": pf.catalogVersion,
-		"StoreId":        storeId,
-	})
+	reqBody := map[string]interface{}{
+		"StoreId": storeId,
+	}
+	if pf.catalogVersion != "" {
+		reqBody["CatalogVersion"] = pf.catalogVersion
+	}
+	requestBody, err := json.Marshal(reqBody)
 
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := pf.request("POST", "Server", "GetStoreItems", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "GetStoreItems", requestBody)
 
 	if err != nil {
 		return nil, err
@@ -514,16 +612,22 @@ func (pf *PlayFab) GetStore(storeId string) (map[string]interface{}, error) {
 }
 
 func (pf *PlayFab) GetCatalogItems() ([]interface{}, error) {
+	return pf.GetCatalogItemsCtx(context.Background())
+}
+
+func (pf *PlayFab) GetCatalogItemsCtx(ctx context.Context) ([]interface{}, error) {
 	pf.logger.Debug("starting GetCatalogItems")
-	requestBody, err := json.Marshal(map[string]interface{}{
-		"CatalogVersion": pf.catalogVersion,
-	})
+	reqBody := map[string]interface{}{}
+	if pf.catalogVersion != "" {
+		reqBody["CatalogVersion"] = pf.catalogVersion
+	}
+	requestBody, err := json.Marshal(reqBody)
 
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := pf.request("POST", "Server", "GetCatalogItems", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "GetCatalogItems", requestBody)
 
 	if err != nil {
 		return nil, err
@@ -548,6 +652,10 @@ func (pf *PlayFab) GetCatalogItems() ([]interface{}, error) {
 }
 
 func (pf *PlayFab) GetUserInventory(playFabId string) ([]interface{}, error) {
+	return pf.GetUserInventoryCtx(context.Background(), playFabId)
+}
+
+func (pf *PlayFab) GetUserInventoryCtx(ctx context.Context, playFabId string) ([]interface{}, error) {
 
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"PlayFabId": playFabId,
@@ -557,7 +665,7 @@ func (pf *PlayFab) GetUserInventory(playFabId string) ([]interface{}, error) {
 		return nil, err
 	}
 
-	body, err := pf.request("POST", "Server", "GetUserInventory", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "GetUserInventory", requestBody)
 	if err != nil {
 		return nil, err
 	}
@@ -581,39 +689,28 @@ func (pf *PlayFab) GetUserInventory(playFabId string) ([]interface{}, error) {
 }
 
 func (pf *PlayFab) GetVirtualCurrency(playFabId string) (map[string]interface{}, error) {
+	return pf.GetVirtualCurrencyCtx(context.Background(), playFabId)
+}
 
-	requestBody, err := json.Marshal(map[string]interface{}{
-		"PlayFabId": playFabId,
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := pf.request("POST", "Server", "GetUserInventory", requestBody)
+func (pf *PlayFab) GetVirtualCurrencyCtx(ctx context.Context, playFabId string) (map[string]interface{}, error) {
+	res, err := pf.GetVirtualCurrencyBalances(ctx, playFabId)
 	if err != nil {
 		return nil, err
 	}
-	res := make(map[string]interface{})
-	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, err
-	}
-
-	data, ok := res["data"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("Failed to parse GetUserCurrency result")
-	}
 
-	virtualCurrency, ok := data["VirtualCurrency"].(map[string]interface{})
-
-	if !ok {
-		return nil, fmt.Errorf("Failed to parse GetUserCurrency result")
+	virtualCurrency := make(map[string]interface{}, len(res.VirtualCurrency))
+	for k, v := range res.VirtualCurrency {
+		virtualCurrency[k] = float64(v)
 	}
 
 	return virtualCurrency, nil
 }
 
 func (pf *PlayFab) AddUserVirtualCurrency(amount uint64, currencyId string, playFabId string) (map[string]interface{}, error) {
+	return pf.AddUserVirtualCurrencyCtx(context.Background(), amount, currencyId, playFabId)
+}
+
+func (pf *PlayFab) AddUserVirtualCurrencyCtx(ctx context.Context, amount uint64, currencyId string, playFabId string) (map[string]interface{}, error) {
 	pf.logger.Debug("starting AddUserVirtualCurrency")
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"Amount":          amount,
@@ -625,7 +722,7 @@ func (pf *PlayFab) AddUserVirtualCurrency(amount uint64, currencyId string, play
 		return nil, err
 	}
 
-	body, err := pf.request("POST", "Server", "AddUserVirtualCurrency", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "AddUserVirtualCurrency", requestBody)
 
 	if err != nil {
 		return nil, err
@@ -645,6 +742,10 @@ func (pf *PlayFab) AddUserVirtualCurrency(amount uint64, currencyId string, play
 }
 
 func (pf *PlayFab) SubtractUserVirtualCurrency(amount uint64, currencyId string, playFabId string) (map[string]interface{}, error) {
+	return pf.SubtractUserVirtualCurrencyCtx(context.Background(), amount, currencyId, playFabId)
+}
+
+func (pf *PlayFab) SubtractUserVirtualCurrencyCtx(ctx context.Context, amount uint64, currencyId string, playFabId string) (map[string]interface{}, error) {
 	pf.logger.Debug("starting SubtractUserVirtualCurrency")
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"Amount":          amount,
@@ -656,7 +757,7 @@ func (pf *PlayFab) SubtractUserVirtualCurrency(amount uint64, currencyId string,
 		return nil, err
 	}
 
-	body, err := pf.request("POST", "Server", "SubtractUserVirtualCurrency", requestBody)
+	body, err := pf.request(ctx, "POST", "Server", "SubtractUserVirtualCurrency", requestBody)
 
 	if err != nil {
 		return nil, err
@@ -675,26 +776,23 @@ func (pf *PlayFab) SubtractUserVirtualCurrency(amount uint64, currencyId string,
 	return data, nil
 }
 
-func (pf *PlayFab) ConsumeItem(playFabId string, itemInstanceId string, consumeCount int) (interface{}, error) {
-	requestBody, err := json.Marshal(map[string]interface{}{
-		"PlayFabId":      playFabId,
-		"ItemInstanceId": itemInstanceId,
-		"ConsumeCount":   consumeCount,
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := pf.request("POST", "Server", "ConsumeItem", requestBody)
-	if err != nil {
-		return nil, err
-	}
+func (pf *PlayFab) ConsumeItem(playFabId string, itemInstanceId string, consumeCount int) (*ConsumeItemResult, error) {
+	return pf.ConsumeItemCtx(context.Background(), playFabId, itemInstanceId, consumeCount)
+}
 
-	return body, nil
+func (pf *PlayFab) ConsumeItemCtx(ctx context.Context, playFabId string, itemInstanceId string, consumeCount int) (*ConsumeItemResult, error) {
+	return pf.ConsumeItemTyped(ctx, &ConsumeItemRequest{
+		PlayFabId:      playFabId,
+		ItemInstanceId: itemInstanceId,
+		ConsumeCount:   int32(consumeCount),
+	})
 }
 
 func (pf *PlayFab) RevokeInventoryItems(revokeInventoryItems []map[string]interface{}) error {
+	return pf.RevokeInventoryItemsCtx(context.Background(), revokeInventoryItems)
+}
+
+func (pf *PlayFab) RevokeInventoryItemsCtx(ctx context.Context, revokeInventoryItems []map[string]interface{}) error {
 
 	// Make sure there are no empty/nil cells in the slice
 	newRevokeInventoryItems := make([]interface{}, 0, len(revokeInventoryItems))
@@ -717,7 +815,7 @@ func (pf *PlayFab) RevokeInventoryItems(revokeInventoryItems []map[string]interf
 		return err
 	}
 
-	_, err = pf.request("POST", "Server", "RevokeInventoryItems", requestBody)
+	_, err = pf.request(ctx, "POST", "Server", "RevokeInventoryItems", requestBody)
 
 	if err != nil {
 		return err
@@ -727,6 +825,10 @@ func (pf *PlayFab) RevokeInventoryItems(revokeInventoryItems []map[string]interf
 }
 
 func (pf *PlayFab) SendPushNotification(message string, recipient string) error {
+	return pf.SendPushNotificationCtx(context.Background(), message, recipient)
+}
+
+func (pf *PlayFab) SendPushNotificationCtx(ctx context.Context, message string, recipient string) error {
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"Message":   message,
 		"Recipient": recipient,
@@ -736,7 +838,7 @@ func (pf *PlayFab) SendPushNotification(message string, recipient string) error
 		return err
 	}
 
-	_, err = pf.request("POST", "Server", "SendPushNotification", requestBody)
+	_, err = pf.request(ctx, "POST", "Server", "SendPushNotification", requestBody)
 
 	if err != nil {
 		return err
@@ -746,6 +848,10 @@ func (pf *PlayFab) SendPushNotification(message string, recipient string) error
 }
 
 func (pf *PlayFab) AddPlayerTag(tag string, playFabId string) error {
+	return pf.AddPlayerTagCtx(context.Background(), tag, playFabId)
+}
+
+func (pf *PlayFab) AddPlayerTagCtx(ctx context.Context, tag string, playFabId string) error {
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"PlayFabId": playFabId,
 		"TagName":   tag,
@@ -755,7 +861,7 @@ func (pf *PlayFab) AddPlayerTag(tag string, playFabId string) error {
 		return err
 	}
 
-	_, err = pf.request("POST", "Server", "AddPlayerTag", requestBody)
+	_, err = pf.request(ctx, "POST", "Server", "AddPlayerTag", requestBody)
 
 	if err != nil {
 		return err
@@ -765,6 +871,10 @@ func (pf *PlayFab) AddPlayerTag(tag string, playFabId string) error {
 }
 
 func (pf *PlayFab) RemovePlayerTag(tag string, playFabId string) error {
+	return pf.RemovePlayerTagCtx(context.Background(), tag, playFabId)
+}
+
+func (pf *PlayFab) RemovePlayerTagCtx(ctx context.Context, tag string, playFabId string) error {
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"PlayFabId": playFabId,
 		"TagName":   tag,
@@ -774,7 +884,7 @@ func (pf *PlayFab) RemovePlayerTag(tag string, playFabId string) error {
 		return err
 	}
 
-	_, err = pf.request("POST", "Server", "RemovePlayerTag", requestBody)
+	_, err = pf.request(ctx, "POST", "Server", "RemovePlayerTag", requestBody)
 
 	if err != nil {
 		return err
@@ -784,6 +894,10 @@ func (pf *PlayFab) RemovePlayerTag(tag string, playFabId string) error {
 }
 
 func (pf *PlayFab) GetPlayerTags(playFabId string) ([]string, error) {
+	return pf.GetPlayerTagsCtx(context.Background(), playFabId)
+}
+
+func (pf *PlayFab) GetPlayerTagsCtx(ctx context.Context, playFabId string) ([]string, error) {
 	requestBody, err := json.Marshal(map[string]interface{}{
 		"PlayFabId": playFabId,
 	})
@@ -792,7 +906,7 @@ func (pf *PlayFab) GetPlayerTags(playFabId string) ([]string, error) {
 		return nil, err
 	}
 
-	d, err := pf.request("POST", "Server", "GetPlayerTags", requestBody)
+	d, err := pf.request(ctx, "POST", "Server", "GetPlayerTags", requestBody)
 
 	if err != nil {
 		return nil, err
@@ -825,52 +939,68 @@ func (pf *PlayFab) GetPlayerTags(playFabId string) ([]string, error) {
 	return tags, nil
 }
 
-func (pf *PlayFab) request(method string, api string, funcName string, reqBody []byte) (d []byte, err error) {
-
-	counter := 0
-
-	for counter <= retries {
-		counter++
-		pf.logger.Debug("Starting retry %d for playfab request", counter)
-		d, oerr := _request(pf.hc, method, pf.titleId, api, funcName, reqBody, pf.secret)
-		if oerr != nil {
-			errorData, err := ConvertToPlayFabErrorJson(oerr, method)
-			if err != nil {
-				isServiceUnavailableError := strings.Contains(err.Error(), "Service Unavailable")
-				isBadRequestError := strings.Contains(err.Error(), "Bad Request")
-				isBadGateWay := strings.Contains(err.Error(), "Bad Gateway")
-				if !isServiceUnavailableError && !isBadRequestError && !isBadGateWay {
-					return d, err
-				}
-				pf.logger.Error("waiting for retry after error - %s", err.Error())
-			} else {
-				err, isConflictError := isConflictError(errorData)
-				if err != nil {
-					return d, err
-				}
-
-				if !isConflictError {
-					return d, oerr
-				}
-			}
-			time.Sleep(1 * time.Second)
-		} else {
+// authHeader is the header a request authenticates with, such as
+// X-SecretKey for the Server API or X-Authorization for a player session.
+type authHeader struct {
+	name  string
+	value string
+}
+
+func (pf *PlayFab) request(ctx context.Context, method string, api string, funcName string, reqBody []byte) ([]byte, error) {
+	return pf.requestAs(ctx, authHeader{"X-SecretKey", pf.secret}, method, api, funcName, reqBody)
+}
+
+func (pf *PlayFab) requestAs(ctx context.Context, auth authHeader, method string, api string, funcName string, reqBody []byte) ([]byte, error) {
+	attempts := pf.retry.MaxAttempts()
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	endpoint := pf.endpoints.ResolveEndpoint(pf.titleId, api, funcName)
+
+	for attempt := 1; ; attempt++ {
+		pf.logger.Debug("Starting attempt %d for playfab request %s", attempt, funcName)
+		d, err := _request(ctx, pf.hc, method, endpoint, funcName, reqBody, auth)
+		if err == nil {
 			return d, nil
 		}
-	}
+		if ctx.Err() != nil {
+			return d, ctx.Err()
+		}
+		var pfErr *PlayFabError
+		if !errors.As(err, &pfErr) {
+			err = &PlayFabError{
+				originError: err,
+				Method:      funcName,
+				ErrorCode:   ErrUnknown,
+				ErrorMsg:    "Failed to convert to playfab error",
+			}
+		}
+		if attempt >= attempts || !pf.retry.Retryable(funcName, err) {
+			return d, err
+		}
 
-	return d, err
+		wait := pf.retry.Backoff(attempt, err)
+		pf.logger.Error("waiting %s for retry after error - %s", wait, err.Error())
+		select {
+		case <-ctx.Done():
+			return d, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
 }
 
-func _request(hc *http.Client, method string, titleId string, api string, funcName string, reqBody []byte, secretKey string) ([]byte, error) {
-	req, err := http.NewRequest(method, fmt.Sprintf(url, titleId, api, funcName), bytes.NewBuffer(reqBody))
+func _request(ctx context.Context, hc *http.Client, method string, endpoint string, funcName string, reqBody []byte, auth authHeader) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewBuffer(reqBody))
 
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Add("Content-type", "application/json")
-	req.Header.Add("X-SecretKey", secretKey)
+	if auth.name != "" {
+		req.Header.Add(auth.name, auth.value)
+	}
 	resp, err := hc.Do(req)
 
 	if err != nil {
@@ -885,13 +1015,25 @@ func _request(hc *http.Client, method string, titleId string, api string, funcNa
 	}
 
 	if resp.StatusCode != 200 {
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
 		res := make(map[string]interface{})
 		if err := json.Unmarshal(resBody, &res); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal: %s", string(resBody))
+			return resBody, &PlayFabError{
+				originError: fmt.Errorf("failed to unmarshal: %s", string(resBody)),
+				Body:        resBody,
+				Method:      funcName,
+				RespCode:    resp.StatusCode,
+				ErrorCode:   ErrUnknown,
+				RetryAfter:  retryAfter,
+			}
 		}
 		errorCode, _ := res["errorCode"].(float64)
 		errorMessage, _ := res["errorMessage"].(string)
-		errorDetails, _ := res["errorDetails"].(string)
+		status, _ := res["status"].(string)
+		details := parseErrorDetails(res["errorDetails"])
+		if seconds, ok := res["retryAfterSeconds"].(float64); ok && retryAfter == 0 {
+			retryAfter = time.Duration(seconds * float64(time.Second))
+		}
 		return resBody, &PlayFabError{
 			originError:  fmt.Errorf("Failed To Process Request With status code %d: %s", resp.StatusCode, string(resBody)),
 			Body:         resBody,
@@ -899,28 +1041,16 @@ func _request(hc *http.Client, method string, titleId string, api string, funcNa
 			RespCode:     resp.StatusCode,
 			ErrorCode:    int(errorCode),
 			ErrorMsg:     errorMessage,
-			ErrorDetails: errorDetails,
+			ErrorDetails: formatErrorDetails(details),
+			Status:       status,
+			Details:      details,
+			RetryAfter:   retryAfter,
 		}
 	}
 
 	return resBody, nil
 }
 
-func isConflictError(errorData map[string]interface{}) (error, bool) {
-	errStatus, ok := errorData["status"].(string)
-
-	if !ok {
-		err := fmt.Errorf("Failed to parse status from error")
-		return err, false
-	}
-
-	if errStatus != conflictStatus {
-		return nil, false
-	}
-
-	return nil, true
-}
-
 func ConvertToPlayFabErrorJson(oerr error, method string) (map[string]interface{}, error) {
 	errorData := make(map[string]interface{})
 	serr, ok := oerr.(*PlayFabError)
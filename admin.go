@@ -0,0 +1,117 @@
+package playfab
+
+import "context"
+
+// Admin gives access to the PlayFab Admin API. It shares the credentials,
+// transport and retry policy of the PlayFab client it was obtained from.
+type Admin struct {
+	pf *PlayFab
+}
+
+func (pf *PlayFab) Admin() *Admin {
+	return &Admin{pf: pf}
+}
+
+type ResultTableNodeType string
+
+const (
+	ResultTableNodeItemId  ResultTableNodeType = "ItemId"
+	ResultTableNodeTableId ResultTableNodeType = "TableId"
+)
+
+type SetTitleDataRequest struct {
+	Key   string
+	Value string `json:",omitempty"`
+}
+
+type UpdateCatalogItemsRequest struct {
+	CatalogVersion      string `json:",omitempty"`
+	Catalog             []CatalogItem
+	SetAsDefaultCatalog *bool `json:",omitempty"`
+}
+
+type UpdateStoreItemsRequest struct {
+	CatalogVersion string `json:",omitempty"`
+	StoreId        string
+	Store          []StoreItem
+	MarketingData  *StoreMarketingModel `json:",omitempty"`
+}
+
+type VirtualCurrencyData struct {
+	CurrencyCode   string
+	DisplayName    string `json:",omitempty"`
+	InitialDeposit *int32 `json:",omitempty"`
+	RechargeRate   *int32 `json:",omitempty"`
+	RechargeMax    *int32 `json:",omitempty"`
+}
+
+type AddVirtualCurrencyTypesRequest struct {
+	VirtualCurrencies []VirtualCurrencyData
+}
+
+type ResultTableNode struct {
+	ResultItemType ResultTableNodeType
+	ResultItem     string
+	Weight         int32
+}
+
+type RandomResultTable struct {
+	TableId string
+	Nodes   []ResultTableNode
+}
+
+type UpdateRandomResultTablesRequest struct {
+	CatalogVersion string `json:",omitempty"`
+	Tables         []RandomResultTable
+}
+
+// SetTitleData sets a title data key. An empty Value deletes the key.
+func (a *Admin) SetTitleData(ctx context.Context, req *SetTitleDataRequest) error {
+	return a.pf.call(ctx, "Admin", "SetTitleData", req, nil)
+}
+
+func (a *Admin) SetTitleInternalData(ctx context.Context, req *SetTitleDataRequest) error {
+	return a.pf.call(ctx, "Admin", "SetTitleInternalData", req, nil)
+}
+
+// SetCatalogItems replaces the whole catalog version with req.Catalog.
+func (a *Admin) SetCatalogItems(ctx context.Context, req *UpdateCatalogItemsRequest) error {
+	r := *req
+	r.CatalogVersion = a.pf.catalog(r.CatalogVersion)
+	return a.pf.call(ctx, "Admin", "SetCatalogItems", &r, nil)
+}
+
+// UpdateCatalogItems adds req.Catalog to the catalog version, replacing
+// items with the same ItemId.
+func (a *Admin) UpdateCatalogItems(ctx context.Context, req *UpdateCatalogItemsRequest) error {
+	r := *req
+	r.CatalogVersion = a.pf.catalog(r.CatalogVersion)
+	return a.pf.call(ctx, "Admin", "UpdateCatalogItems", &r, nil)
+}
+
+// SetStoreItems creates or replaces a store.
+func (a *Admin) SetStoreItems(ctx context.Context, req *UpdateStoreItemsRequest) error {
+	r := *req
+	r.CatalogVersion = a.pf.catalog(r.CatalogVersion)
+	return a.pf.call(ctx, "Admin", "SetStoreItems", &r, nil)
+}
+
+// UpdateStoreItems adds items to an existing store, replacing items with the
+// same ItemId.
+func (a *Admin) UpdateStoreItems(ctx context.Context, req *UpdateStoreItemsRequest) error {
+	r := *req
+	r.CatalogVersion = a.pf.catalog(r.CatalogVersion)
+	return a.pf.call(ctx, "Admin", "UpdateStoreItems", &r, nil)
+}
+
+func (a *Admin) AddVirtualCurrencyTypes(ctx context.Context, req *AddVirtualCurrencyTypesRequest) error {
+	return a.pf.call(ctx, "Admin", "AddVirtualCurrencyTypes", req, nil)
+}
+
+// UpdateRandomResultTables creates or replaces the given drop tables of the
+// catalog version.
+func (a *Admin) UpdateRandomResultTables(ctx context.Context, req *UpdateRandomResultTablesRequest) error {
+	r := *req
+	r.CatalogVersion = a.pf.catalog(r.CatalogVersion)
+	return a.pf.call(ctx, "Admin", "UpdateRandomResultTables", &r, nil)
+}
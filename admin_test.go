@@ -0,0 +1,165 @@
+package playfab_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func itemIds(items []playfab.CatalogItem) []string {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ItemId
+	}
+	return ids
+}
+
+func TestAdminCatalogItems(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+	admin := pf.Admin()
+	ctx := context.Background()
+
+	err := admin.SetCatalogItems(ctx, &playfab.UpdateCatalogItemsRequest{
+		Catalog: []playfab.CatalogItem{{ItemId: "sword"}, {ItemId: "shield"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = admin.UpdateCatalogItems(ctx, &playfab.UpdateCatalogItemsRequest{
+		Catalog: []playfab.CatalogItem{{ItemId: "shield", DisplayName: "Kite Shield"}, {ItemId: "bow"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	catalog := srv.Catalog("main")
+	if ids := itemIds(catalog); len(ids) != 3 || ids[0] != "sword" || ids[1] != "shield" || ids[2] != "bow" {
+		t.Fatalf("got %v, want sword, shield and bow", ids)
+	}
+	if catalog[1].DisplayName != "Kite Shield" {
+		t.Errorf("got %+v, want the updated shield", catalog[1])
+	}
+
+	err = admin.SetCatalogItems(ctx, &playfab.UpdateCatalogItemsRequest{
+		CatalogVersion: "next",
+		Catalog:        []playfab.CatalogItem{{ItemId: "axe"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := itemIds(srv.Catalog("next")); len(ids) != 1 || ids[0] != "axe" {
+		t.Errorf("got %v, want the per call catalog set", ids)
+	}
+	if n := len(srv.Catalog("main")); n != 3 {
+		t.Errorf("client catalog has %d items, want 3", n)
+	}
+}
+
+func TestAdminStoreItems(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+	admin := pf.Admin()
+	ctx := context.Background()
+	update := &playfab.UpdateStoreItemsRequest{
+		StoreId: "shop",
+		Store:   []playfab.StoreItem{{ItemId: "shield", VirtualCurrencyPrices: map[string]uint32{"GO": 8}}},
+	}
+
+	if err := admin.UpdateStoreItems(ctx, update); !errors.Is(err, playfab.ErrStoreNotFound) {
+		t.Fatalf("got %v, want ErrStoreNotFound", err)
+	}
+	err := admin.SetStoreItems(ctx, &playfab.UpdateStoreItemsRequest{
+		StoreId:       "shop",
+		Store:         []playfab.StoreItem{{ItemId: "sword", VirtualCurrencyPrices: map[string]uint32{"GO": 5}}},
+		MarketingData: &playfab.StoreMarketingModel{DisplayName: "Shop"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := admin.UpdateStoreItems(ctx, update); err != nil {
+		t.Fatal(err)
+	}
+	store, ok := srv.Store("main", "shop")
+	if !ok || len(store.Items) != 2 || store.Items[1].VirtualCurrencyPrices["GO"] != 8 {
+		t.Fatalf("got %+v, want sword and shield", store)
+	}
+	if store.MarketingData == nil || store.MarketingData.DisplayName != "Shop" {
+		t.Errorf("got %+v, want the marketing data kept", store.MarketingData)
+	}
+}
+
+func TestAdminTitleData(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetTitleInternalData(map[string]string{"old": "1"})
+	pf, _ := srv.NewClient("main")
+	admin := pf.Admin()
+	ctx := context.Background()
+
+	if err := admin.SetTitleData(ctx, &playfab.SetTitleDataRequest{Key: "motd", Value: "hi"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := admin.SetTitleInternalData(ctx, &playfab.SetTitleDataRequest{Key: "old"}); err != nil {
+		t.Fatal(err)
+	}
+	if v := srv.TitleData()["motd"]; v != "hi" {
+		t.Errorf("motd is %q, want hi", v)
+	}
+	if data := srv.TitleInternalData(); len(data) != 0 {
+		t.Errorf("got %v, want the key deleted by an empty value", data)
+	}
+}
+
+func TestAdminEconomyAndStatistics(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+	admin := pf.Admin()
+	ctx := context.Background()
+
+	err := admin.AddVirtualCurrencyTypes(ctx, &playfab.AddVirtualCurrencyTypesRequest{
+		VirtualCurrencies: []playfab.VirtualCurrencyData{{CurrencyCode: "GO", DisplayName: "Gold"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vc := srv.VirtualCurrencyTypes()["GO"]; vc.DisplayName != "Gold" {
+		t.Errorf("got %+v, want Gold", vc)
+	}
+
+	err = admin.UpdateRandomResultTables(ctx, &playfab.UpdateRandomResultTablesRequest{
+		Tables: []playfab.RandomResultTable{{
+			TableId: "loot",
+			Nodes:   []playfab.ResultTableNode{{ResultItemType: playfab.ResultTableNodeItemId, ResultItem: "gem", Weight: 1}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if items := srv.RandomResultTable("loot"); len(items) != 1 || items[0] != "gem" {
+		t.Errorf("got %v, want gem", items)
+	}
+
+	def, err := admin.CreatePlayerStatisticDefinition(ctx, &playfab.CreatePlayerStatisticDefinitionRequest{
+		StatisticName:     "score",
+		AggregationMethod: playfab.StatisticAggregationMax,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Statistic == nil || def.Statistic.StatisticName != "score" || def.Statistic.AggregationMethod != playfab.StatisticAggregationMax {
+		t.Errorf("got %+v", def.Statistic)
+	}
+	inc, err := admin.IncrementPlayerStatisticVersion(ctx, &playfab.IncrementPlayerStatisticVersionRequest{StatisticName: "score"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inc.StatisticVersion == nil || inc.StatisticVersion.Version != 1 {
+		t.Errorf("got %+v, want version 1", inc.StatisticVersion)
+	}
+}
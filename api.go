@@ -0,0 +1,218 @@
+package playfab
+
+import "context"
+
+//go:generate moq -out playfabmock/player_data.go -pkg playfabmock . PlayerDataAPI:PlayerDataAPIMock
+//go:generate moq -out playfabmock/inventory.go -pkg playfabmock . InventoryAPI:InventoryAPIMock
+//go:generate moq -out playfabmock/currency.go -pkg playfabmock . CurrencyAPI:CurrencyAPIMock
+//go:generate moq -out playfabmock/catalog.go -pkg playfabmock . CatalogAPI:CatalogAPIMock
+//go:generate moq -out playfabmock/title_data.go -pkg playfabmock . TitleDataAPI:TitleDataAPIMock
+//go:generate moq -out playfabmock/tags.go -pkg playfabmock . TagsAPI:TagsAPIMock
+//go:generate moq -out playfabmock/statistics.go -pkg playfabmock . StatisticsAPI:StatisticsAPIMock
+//go:generate moq -out playfabmock/leaderboard.go -pkg playfabmock . LeaderboardAPI:LeaderboardAPIMock
+//go:generate moq -out playfabmock/notification.go -pkg playfabmock . NotificationAPI:NotificationAPIMock
+//go:generate moq -out playfabmock/admin.go -pkg playfabmock . AdminAPI:AdminAPIMock
+//go:generate moq -out playfabmock/client.go -pkg playfabmock . ClientAPI:ClientAPIMock
+//go:generate moq -out playfabmock/entity.go -pkg playfabmock . EntityAPI:EntityAPIMock
+
+// The interfaces below group the methods of PlayFab by feature area so
+// consumers can depend on, and mock, only what they use. The playfabmock
+// package holds a generated mock for each of them.
+
+type PlayerDataAPI interface {
+	GetUserInternalData(keys []string, playFabId string) (map[string]interface{}, error)
+	GetUserInternalDataCtx(ctx context.Context, keys []string, playFabId string) (map[string]interface{}, error)
+	GetUserInternalDataTyped(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error)
+	UpdateUserInternalData(data map[string]string, playFabId string, keysToRemove []string) error
+	UpdateUserInternalDataCtx(ctx context.Context, data map[string]string, playFabId string, keysToRemove []string) error
+	UpdateUserInternalDataTyped(ctx context.Context, req *UpdateUserInternalDataRequest) (*UpdateUserDataResult, error)
+	GetUserReadOnlyData(keys []string, playFabId string) (map[string]interface{}, error)
+	GetUserReadOnlyDataCtx(ctx context.Context, keys []string, playFabId string) (map[string]interface{}, error)
+	GetUserReadOnlyDataTyped(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error)
+	UpdateUserReadOnlyData(data map[string]string, playFabId string) error
+	UpdateUserReadOnlyDataCtx(ctx context.Context, data map[string]string, playFabId string) error
+	UpdateUserReadOnlyDataTyped(ctx context.Context, req *UpdateUserDataRequest) (*UpdateUserDataResult, error)
+	GetPlayerCombinedInfo(reqInfo map[string]interface{}, playFabId string) (map[string]interface{}, error)
+	GetPlayerCombinedInfoCtx(ctx context.Context, reqInfo map[string]interface{}, playFabId string) (map[string]interface{}, error)
+	GetPlayerCombinedInfoTyped(ctx context.Context, req *GetPlayerCombinedInfoRequest) (*GetPlayerCombinedInfoResult, error)
+	GetUserInternalValue(ctx context.Context, playFabId string, key string, out interface{}) (*UserDataInfo, error)
+	SetUserInternalValue(ctx context.Context, playFabId string, key string, v interface{}) error
+	GetUserReadOnlyValue(ctx context.Context, playFabId string, key string, out interface{}) (*UserDataInfo, error)
+	SetUserReadOnlyValue(ctx context.Context, playFabId string, key string, v interface{}) error
+	UpdateUserInternalDataIfVersion(ctx context.Context, req *UpdateUserInternalDataRequest, version uint32) (*UpdateUserDataResult, error)
+	UpdateUserInternalDataCAS(ctx context.Context, playFabId string, keys []string, fn func(current map[string]string) (map[string]string, error)) error
+	UpdateUserReadOnlyDataIfVersion(ctx context.Context, req *UpdateUserDataRequest, version uint32) (*UpdateUserDataResult, error)
+	UpdateUserReadOnlyDataCAS(ctx context.Context, playFabId string, keys []string, fn func(current map[string]string) (map[string]string, error)) error
+	GetUserData(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error)
+	UpdateUserData(ctx context.Context, req *UpdateUserDataRequest) (*UpdateUserDataResult, error)
+	UpdateUserDataIfVersion(ctx context.Context, req *UpdateUserDataRequest, version uint32) (*UpdateUserDataResult, error)
+	UpdateUserDataCAS(ctx context.Context, playFabId string, keys []string, fn func(current map[string]string) (map[string]string, error)) error
+	GetUserPublisherData(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error)
+	UpdateUserPublisherData(ctx context.Context, req *UpdateUserDataRequest) (*UpdateUserDataResult, error)
+	GetUserPublisherReadOnlyData(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error)
+	UpdateUserPublisherReadOnlyData(ctx context.Context, req *UpdateUserDataRequest) (*UpdateUserDataResult, error)
+	GetUserPublisherInternalData(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error)
+	UpdateUserPublisherInternalData(ctx context.Context, req *UpdateUserInternalDataRequest) (*UpdateUserDataResult, error)
+	GetUserDataRecords(ctx context.Context, kind UserDataKind, req *GetUserDataRequest) (*GetUserDataResult, error)
+	UpdateUserDataRecords(ctx context.Context, kind UserDataKind, req *UpdateUserDataRequest) (*UpdateUserDataResult, error)
+	UpdateUserDataRecordsCAS(ctx context.Context, kind UserDataKind, playFabId string, keys []string, fn func(current map[string]string) (map[string]string, error)) error
+}
+
+type InventoryAPI interface {
+	GetUserInventory(playFabId string) ([]interface{}, error)
+	GetUserInventoryCtx(ctx context.Context, playFabId string) ([]interface{}, error)
+	GetUserInventoryTyped(ctx context.Context, req *GetUserInventoryRequest) (*GetUserInventoryResult, error)
+	GrantItemsToUser(itemIds []string, playFabId string) ([]interface{}, error)
+	GrantItemsToUserCtx(ctx context.Context, itemIds []string, playFabId string) ([]interface{}, error)
+	GrantItemsToUserTyped(ctx context.Context, req *GrantItemsToUserRequest) (*GrantItemsToUserResult, error)
+	ConsumeItem(playFabId string, itemInstanceId string, consumeCount int) (*ConsumeItemResult, error)
+	ConsumeItemCtx(ctx context.Context, playFabId string, itemInstanceId string, consumeCount int) (*ConsumeItemResult, error)
+	ConsumeItemTyped(ctx context.Context, req *ConsumeItemRequest) (*ConsumeItemResult, error)
+	ConsumeItemsByItemId(ctx context.Context, playFabId string, itemId string, count int32) ([]ConsumeItemResult, error)
+	RevokeInventoryItems(revokeInventoryItems []map[string]interface{}) error
+	RevokeInventoryItemsCtx(ctx context.Context, revokeInventoryItems []map[string]interface{}) error
+	RevokeInventoryItemsTyped(ctx context.Context, req *RevokeInventoryItemsRequest) (*RevokeInventoryItemsResult, error)
+	EvaluateRandomTable(tableId string, playFabId string) (string, error)
+	EvaluateRandomTableCtx(ctx context.Context, tableId string, playFabId string) (string, error)
+	EvaluateRandomTableTyped(ctx context.Context, req *EvaluateRandomResultTableRequest) (*EvaluateRandomResultTableResult, error)
+	Purchase(ctx context.Context, prices PriceSource, req *PurchaseRequest) (*PurchaseResult, error)
+	ModifyItemUses(ctx context.Context, req *ModifyItemUsesRequest) (*ModifyItemUsesResult, error)
+	UpdateUserInventoryItemCustomData(ctx context.Context, req *UpdateUserInventoryItemDataRequest) error
+	MoveItemToUserFromCharacter(ctx context.Context, req *MoveItemRequest) error
+	MoveItemToCharacterFromUser(ctx context.Context, req *MoveItemRequest) error
+	GrantItemsToUsers(ctx context.Context, req *GrantItemsToUsersRequest) (*GrantItemsToUsersResult, error)
+	UnlockContainerInstance(ctx context.Context, req *UnlockContainerInstanceRequest) (*UnlockContainerItemResult, error)
+	UnlockContainerItem(ctx context.Context, req *UnlockContainerItemRequest) (*UnlockContainerItemResult, error)
+	RedeemCoupon(ctx context.Context, req *RedeemCouponRequest) (*RedeemCouponResult, error)
+}
+
+type CurrencyAPI interface {
+	GetVirtualCurrency(playFabId string) (map[string]interface{}, error)
+	GetVirtualCurrencyCtx(ctx context.Context, playFabId string) (map[string]interface{}, error)
+	GetVirtualCurrencyTyped(ctx context.Context, req *GetUserInventoryRequest) (*VirtualCurrencyBalances, error)
+	GetVirtualCurrencyBalances(ctx context.Context, playFabId string) (*VirtualCurrencyBalances, error)
+	GetInventoryAndCurrency(ctx context.Context, playFabId string) (*GetUserInventoryResult, error)
+	AddUserVirtualCurrency(amount uint64, currencyId string, playFabId string) (map[string]interface{}, error)
+	AddUserVirtualCurrencyCtx(ctx context.Context, amount uint64, currencyId string, playFabId string) (map[string]interface{}, error)
+	AddUserVirtualCurrencyTyped(ctx context.Context, req *AddUserVirtualCurrencyRequest) (*ModifyUserVirtualCurrencyResult, error)
+	SubtractUserVirtualCurrency(amount uint64, currencyId string, playFabId string) (map[string]interface{}, error)
+	SubtractUserVirtualCurrencyCtx(ctx context.Context, amount uint64, currencyId string, playFabId string) (map[string]interface{}, error)
+	SubtractUserVirtualCurrencyTyped(ctx context.Context, req *SubtractUserVirtualCurrencyRequest) (*ModifyUserVirtualCurrencyResult, error)
+}
+
+type CatalogAPI interface {
+	GetCatalogItems() ([]interface{}, error)
+	GetCatalogItemsCtx(ctx context.Context) ([]interface{}, error)
+	GetCatalogItemsTyped(ctx context.Context, req *GetCatalogItemsRequest) (*GetCatalogItemsResult, error)
+	GetStoreItems(storeId string, playfabId string) ([]interface{}, string, error)
+	GetStoreItemsCtx(ctx context.Context, storeId string, playfabId string) ([]interface{}, string, error)
+	GetStoreItemsTyped(ctx context.Context, req *GetStoreItemsRequest) (*GetStoreItemsResult, error)
+	GetStore(storeId string) (map[string]interface{}, error)
+	GetStoreCtx(ctx context.Context, storeId string) (map[string]interface{}, error)
+	GetStoreTyped(ctx context.Context, req *GetStoreItemsRequest) (*StoreMarketingModel, error)
+	GetFullStore(ctx context.Context, req *GetStoreItemsRequest) (*Store, error)
+}
+
+type TitleDataAPI interface {
+	GetTitleData(keys []string) (map[string]interface{}, error)
+	GetTitleDataCtx(ctx context.Context, keys []string) (map[string]interface{}, error)
+	GetTitleDataTyped(ctx context.Context, req *GetTitleDataRequest) (*GetTitleDataResult, error)
+	GetTitleInternalData(keys []string) (map[string]interface{}, error)
+	GetTitleInternalDataCtx(ctx context.Context, keys []string) (map[string]interface{}, error)
+	GetTitleInternalDataTyped(ctx context.Context, req *GetTitleDataRequest) (*GetTitleDataResult, error)
+	GetTitleDataValue(ctx context.Context, key string, overrideLabel string, out interface{}) (bool, error)
+	GetTitleInternalDataValue(ctx context.Context, key string, out interface{}) (bool, error)
+	SetTitleData(ctx context.Context, req *SetTitleDataRequest) error
+	SetTitleDataValue(ctx context.Context, key string, value interface{}) error
+	SetTitleInternalData(ctx context.Context, req *SetTitleDataRequest) error
+	SetTitleInternalDataValue(ctx context.Context, key string, value interface{}) error
+	GetPublisherData(ctx context.Context, req *GetPublisherDataRequest) (*GetPublisherDataResult, error)
+	GetPublisherDataValue(ctx context.Context, key string, out interface{}) (bool, error)
+	SetPublisherData(ctx context.Context, req *SetPublisherDataRequest) error
+	SetPublisherDataValue(ctx context.Context, key string, value interface{}) error
+}
+
+type TagsAPI interface {
+	AddPlayerTag(tag string, playFabId string) error
+	AddPlayerTagCtx(ctx context.Context, tag string, playFabId string) error
+	AddPlayerTagTyped(ctx context.Context, req *AddPlayerTagRequest) error
+	RemovePlayerTag(tag string, playFabId string) error
+	RemovePlayerTagCtx(ctx context.Context, tag string, playFabId string) error
+	RemovePlayerTagTyped(ctx context.Context, req *RemovePlayerTagRequest) error
+	GetPlayerTags(playFabId string) ([]string, error)
+	GetPlayerTagsCtx(ctx context.Context, playFabId string) ([]string, error)
+	GetPlayerTagsTyped(ctx context.Context, req *GetPlayerTagsRequest) (*GetPlayerTagsResult, error)
+}
+
+type StatisticsAPI interface {
+	GetPlayerStatistics(statisitcsIds []string, playFabId string) ([]map[string]interface{}, error)
+	GetPlayerStatisticsCtx(ctx context.Context, statisitcsIds []string, playFabId string) ([]map[string]interface{}, error)
+	GetPlayerStatisticsTyped(ctx context.Context, req *GetPlayerStatisticsRequest) (*GetPlayerStatisticsResult, error)
+	UpdatePlayerStatistics(statistics []interface{}, playFabId string) error
+	UpdatePlayerStatisticsCtx(ctx context.Context, statistics []interface{}, playFabId string) error
+	UpdatePlayerStatisticsTyped(ctx context.Context, req *UpdatePlayerStatisticsRequest) error
+	GetPlayerStatisticVersions(ctx context.Context, req *GetPlayerStatisticVersionsRequest) (*GetPlayerStatisticVersionsResult, error)
+}
+
+type LeaderboardAPI interface {
+	GetLeaderboard(ctx context.Context, req *GetLeaderboardRequest) (*GetLeaderboardResult, error)
+	GetLeaderboardAroundUser(ctx context.Context, req *GetLeaderboardAroundUserRequest) (*GetLeaderboardResult, error)
+	GetFriendLeaderboard(ctx context.Context, req *GetFriendLeaderboardRequest) (*GetLeaderboardResult, error)
+}
+
+type NotificationAPI interface {
+	SendPushNotification(message string, recipient string) error
+	SendPushNotificationCtx(ctx context.Context, message string, recipient string) error
+	SendPushNotificationTyped(ctx context.Context, req *SendPushNotificationRequest) error
+}
+
+// ServerAPI is the whole Server API surface of PlayFab.
+type ServerAPI interface {
+	PlayerDataAPI
+	InventoryAPI
+	CurrencyAPI
+	CatalogAPI
+	TitleDataAPI
+	TagsAPI
+	StatisticsAPI
+	LeaderboardAPI
+	NotificationAPI
+}
+
+type AdminAPI interface {
+	SetTitleData(ctx context.Context, req *SetTitleDataRequest) error
+	SetTitleInternalData(ctx context.Context, req *SetTitleDataRequest) error
+	SetTitleDataAndOverrides(ctx context.Context, req *SetTitleDataAndOverridesRequest) error
+	SetTitleDataOverrideValue(ctx context.Context, overrideLabel string, key string, value interface{}) error
+	SetCatalogItems(ctx context.Context, req *UpdateCatalogItemsRequest) error
+	UpdateCatalogItems(ctx context.Context, req *UpdateCatalogItemsRequest) error
+	SetStoreItems(ctx context.Context, req *UpdateStoreItemsRequest) error
+	UpdateStoreItems(ctx context.Context, req *UpdateStoreItemsRequest) error
+	AddVirtualCurrencyTypes(ctx context.Context, req *AddVirtualCurrencyTypesRequest) error
+	UpdateRandomResultTables(ctx context.Context, req *UpdateRandomResultTablesRequest) error
+	CreatePlayerStatisticDefinition(ctx context.Context, req *CreatePlayerStatisticDefinitionRequest) (*CreatePlayerStatisticDefinitionResult, error)
+	IncrementPlayerStatisticVersion(ctx context.Context, req *IncrementPlayerStatisticVersionRequest) (*IncrementPlayerStatisticVersionResult, error)
+}
+
+type ClientAPI interface {
+	LoginWithCustomID(ctx context.Context, req *LoginWithCustomIDRequest) (*Session, error)
+	LoginWithEmailAddress(ctx context.Context, req *LoginWithEmailAddressRequest) (*Session, error)
+	LoginWithAndroidDeviceID(ctx context.Context, req *LoginWithAndroidDeviceIDRequest) (*Session, error)
+	LoginWithIOSDeviceID(ctx context.Context, req *LoginWithIOSDeviceIDRequest) (*Session, error)
+	RegisterPlayFabUser(ctx context.Context, req *RegisterPlayFabUserRequest) (*Session, error)
+}
+
+type EntityAPI interface {
+	Key(ctx context.Context) (*EntityKey, error)
+	Call(ctx context.Context, api string, funcName string, req interface{}, out interface{}) error
+}
+
+var (
+	_ PriceSource = (*CatalogCache)(nil)
+	_ PriceSource = (*Store)(nil)
+
+	_ ServerAPI = (*PlayFab)(nil)
+	_ AdminAPI  = (*Admin)(nil)
+	_ ClientAPI = (*Client)(nil)
+	_ EntityAPI = (*Entity)(nil)
+)
@@ -0,0 +1,193 @@
+package playfab
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+)
+
+const defaultCatalogRefreshInterval = 5 * time.Minute
+
+type CatalogCacheOption func(c *CatalogCache)
+
+// WithCatalogRefreshInterval sets how often the cache reloads the catalog in
+// the background. It defaults to 5 minutes; 0 disables background refresh.
+func WithCatalogRefreshInterval(interval time.Duration) CatalogCacheOption {
+	return func(c *CatalogCache) {
+		c.interval = interval
+	}
+}
+
+// WithCatalogChangeHook calls fn with the new items each time a refresh finds
+// the catalog changed. fn runs on the refreshing goroutine.
+func WithCatalogChangeHook(fn func(items []CatalogItem)) CatalogCacheOption {
+	return func(c *CatalogCache) {
+		c.onChange = append(c.onChange, fn)
+	}
+}
+
+// CatalogCache keeps a catalog version in memory, indexed by ItemId,
+// ItemClass and tag, and reloads it periodically. Lookups never call
+// PlayFab. Every index holds its own copy of the items; the slices, maps and
+// pointers in what lookups return are shared and must not be modified.
+type CatalogCache struct {
+	pf             *PlayFab
+	catalogVersion string
+	interval       time.Duration
+	onChange       []func(items []CatalogItem)
+
+	mu       sync.RWMutex
+	items    []CatalogItem
+	byId     map[string]CatalogItem
+	byClass  map[string][]CatalogItem
+	byTag    map[string][]CatalogItem
+	loadedAt time.Time
+
+	refreshMu sync.Mutex
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// NewCatalogCache loads catalogVersion, or the client's catalog version when
+// it is empty, and starts refreshing it in the background. Close stops the
+// refresh.
+func (pf *PlayFab) NewCatalogCache(ctx context.Context, catalogVersion string, opts ...CatalogCacheOption) (*CatalogCache, error) {
+	c := &CatalogCache{
+		pf:             pf,
+		catalogVersion: pf.catalog(catalogVersion),
+		interval:       defaultCatalogRefreshInterval,
+		done:           make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if err := c.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	bgCtx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	go c.run(bgCtx)
+	return c, nil
+}
+
+func (c *CatalogCache) run(ctx context.Context) {
+	defer close(c.done)
+	if c.interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Refresh(ctx); err != nil && ctx.Err() == nil {
+				c.pf.logger.Warn("Failed to refresh catalog %s, keeping the cached one: %v", c.catalogVersion, err)
+			}
+		}
+	}
+}
+
+// Close stops the background refresh. Lookups keep working on the last
+// loaded catalog.
+func (c *CatalogCache) Close() {
+	c.cancel()
+	<-c.done
+}
+
+// Refresh reloads the catalog now.
+func (c *CatalogCache) Refresh(ctx context.Context) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	res, err := c.pf.GetCatalogItemsTyped(ctx, &GetCatalogItemsRequest{CatalogVersion: c.catalogVersion})
+	if err != nil {
+		return err
+	}
+
+	byId := make(map[string]CatalogItem, len(res.Catalog))
+	byClass := make(map[string][]CatalogItem)
+	byTag := make(map[string][]CatalogItem)
+	for _, item := range res.Catalog {
+		byId[item.ItemId] = item
+		byClass[item.ItemClass] = append(byClass[item.ItemClass], item)
+		for _, tag := range item.Tags {
+			byTag[tag] = append(byTag[tag], item)
+		}
+	}
+
+	c.mu.Lock()
+	changed := !c.loadedAt.IsZero() && !reflect.DeepEqual(c.items, res.Catalog)
+	c.items = res.Catalog
+	c.byId = byId
+	c.byClass = byClass
+	c.byTag = byTag
+	c.loadedAt = time.Now()
+	c.mu.Unlock()
+
+	if changed {
+		for _, fn := range c.onChange {
+			fn(res.Catalog)
+		}
+	}
+	return nil
+}
+
+func (c *CatalogCache) CatalogVersion() string {
+	return c.catalogVersion
+}
+
+// LoadedAt returns when the catalog was last loaded successfully.
+func (c *CatalogCache) LoadedAt() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.loadedAt
+}
+
+func (c *CatalogCache) Items() []CatalogItem {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.items
+}
+
+func (c *CatalogCache) Item(itemId string) (CatalogItem, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	item, ok := c.byId[itemId]
+	return item, ok
+}
+
+func (c *CatalogCache) ItemsByClass(itemClass string) []CatalogItem {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.byClass[itemClass]
+}
+
+func (c *CatalogCache) ItemsByTag(tag string) []CatalogItem {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.byTag[tag]
+}
+
+// Price returns the price of itemId in currency.
+func (c *CatalogCache) Price(itemId string, currency string) (uint32, bool) {
+	item, ok := c.Item(itemId)
+	if !ok {
+		return 0, false
+	}
+	price, ok := item.VirtualCurrencyPrices[currency]
+	return price, ok
+}
+
+// Bundle returns the bundle contents of itemId, or nil when it is not a
+// bundle.
+func (c *CatalogCache) Bundle(itemId string) *CatalogItemBundleInfo {
+	item, ok := c.Item(itemId)
+	if !ok {
+		return nil
+	}
+	return item.Bundle
+}
@@ -0,0 +1,102 @@
+package playfab_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func newCatalogServer() *playfabtest.Server {
+	srv := playfabtest.NewServer()
+	srv.SetCatalog("main",
+		playfab.CatalogItem{ItemId: "sword", ItemClass: "weapon", Tags: []string{"melee"}, VirtualCurrencyPrices: map[string]uint32{"GO": 5}},
+		playfab.CatalogItem{ItemId: "axe", ItemClass: "weapon", Tags: []string{"melee", "heavy"}},
+		playfab.CatalogItem{ItemId: "pack", ItemClass: "bundle", Bundle: &playfab.CatalogItemBundleInfo{BundledItems: []string{"sword"}}},
+	)
+	return srv
+}
+
+func TestCatalogCacheLookups(t *testing.T) {
+	srv := newCatalogServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+	c, err := pf.NewCatalogCache(context.Background(), "", playfab.WithCatalogRefreshInterval(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if item, ok := c.Item("sword"); !ok || item.ItemClass != "weapon" {
+		t.Errorf("got %+v, %v", item, ok)
+	}
+	if _, ok := c.Item("bow"); ok {
+		t.Error("found an item that is not in the catalog")
+	}
+	if items := c.ItemsByClass("weapon"); len(items) != 2 {
+		t.Errorf("got %d weapons, want 2", len(items))
+	}
+	if items := c.ItemsByTag("heavy"); len(items) != 1 || items[0].ItemId != "axe" {
+		t.Errorf("got %+v, want the axe", items)
+	}
+	if price, ok := c.Price("sword", "GO"); !ok || price != 5 {
+		t.Errorf("got %d, %v", price, ok)
+	}
+	if b := c.Bundle("pack"); b == nil || b.BundledItems[0] != "sword" {
+		t.Errorf("got %+v", b)
+	}
+	if c.Bundle("sword") != nil {
+		t.Error("sword is not a bundle")
+	}
+
+	item, _ := c.Item("sword")
+	item.ItemClass = "changed"
+	if again, _ := c.Item("sword"); again.ItemClass != "weapon" {
+		t.Error("changing a looked up item changed the cache")
+	}
+	if srv.Calls("GetCatalogItems") != 1 {
+		t.Errorf("lookups called PlayFab")
+	}
+}
+
+func TestCatalogCacheRefresh(t *testing.T) {
+	srv := newCatalogServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+	changes := make(chan []playfab.CatalogItem, 10)
+	c, err := pf.NewCatalogCache(context.Background(), "",
+		playfab.WithCatalogRefreshInterval(10*time.Millisecond),
+		playfab.WithCatalogChangeHook(func(items []playfab.CatalogItem) { changes <- items }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	srv.SetCatalog("main", playfab.CatalogItem{ItemId: "bow", ItemClass: "weapon"})
+	select {
+	case items := <-changes:
+		if len(items) != 1 || items[0].ItemId != "bow" {
+			t.Errorf("hook got %+v, want the new catalog", items)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the background refresh did not pick up the change")
+	}
+	if _, ok := c.Item("bow"); !ok {
+		t.Error("bow is not indexed after the refresh")
+	}
+	if items := c.ItemsByClass("weapon"); len(items) != 1 {
+		t.Errorf("got %d weapons, want the old ones dropped", len(items))
+	}
+
+	c.Close()
+	srv.FailNext("GetCatalogItems", playfabtest.ServiceUnavailable)
+	if err := c.Refresh(context.Background()); err == nil {
+		t.Fatal("want the refresh to fail")
+	}
+	if _, ok := c.Item("bow"); !ok {
+		t.Error("a failed refresh dropped the cached catalog")
+	}
+}
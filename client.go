@@ -0,0 +1,332 @@
+package playfab
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// sessionTicketLifetime is how long PlayFab honors a session ticket. Sessions
+// log in again a little before it runs out.
+const (
+	sessionTicketLifetime = 24 * time.Hour
+	sessionRefreshMargin  = 5 * time.Minute
+)
+
+type LoginResult struct {
+	PlayFabId         string
+	SessionTicket     string
+	NewlyCreated      bool
+	LastLoginTime     *time.Time                          `json:",omitempty"`
+	EntityToken       *EntityTokenResponse                `json:",omitempty"`
+	InfoResultPayload *GetPlayerCombinedInfoResultPayload `json:",omitempty"`
+}
+
+type LoginWithCustomIDRequest struct {
+	TitleId               string
+	CustomId              string
+	CreateAccount         bool
+	InfoRequestParameters *GetPlayerCombinedInfoRequestParams `json:",omitempty"`
+	CustomTags            map[string]string                   `json:",omitempty"`
+}
+
+type LoginWithEmailAddressRequest struct {
+	TitleId               string
+	Email                 string
+	Password              string
+	InfoRequestParameters *GetPlayerCombinedInfoRequestParams `json:",omitempty"`
+	CustomTags            map[string]string                   `json:",omitempty"`
+}
+
+type LoginWithAndroidDeviceIDRequest struct {
+	TitleId               string
+	AndroidDeviceId       string
+	AndroidDevice         string `json:",omitempty"`
+	OS                    string `json:",omitempty"`
+	CreateAccount         bool
+	InfoRequestParameters *GetPlayerCombinedInfoRequestParams `json:",omitempty"`
+	CustomTags            map[string]string                   `json:",omitempty"`
+}
+
+type LoginWithIOSDeviceIDRequest struct {
+	TitleId               string
+	DeviceId              string
+	DeviceModel           string `json:",omitempty"`
+	OS                    string `json:",omitempty"`
+	CreateAccount         bool
+	InfoRequestParameters *GetPlayerCombinedInfoRequestParams `json:",omitempty"`
+	CustomTags            map[string]string                   `json:",omitempty"`
+}
+
+type RegisterPlayFabUserRequest struct {
+	TitleId                     string
+	Username                    string `json:",omitempty"`
+	Email                       string `json:",omitempty"`
+	Password                    string
+	DisplayName                 string                              `json:",omitempty"`
+	RequireBothUsernameAndEmail *bool                               `json:",omitempty"`
+	InfoRequestParameters       *GetPlayerCombinedInfoRequestParams `json:",omitempty"`
+	CustomTags                  map[string]string                   `json:",omitempty"`
+}
+
+type RegisterPlayFabUserResult struct {
+	PlayFabId     string
+	SessionTicket string
+	Username      string               `json:",omitempty"`
+	EntityToken   *EntityTokenResponse `json:",omitempty"`
+}
+
+type PurchaseItemRequest struct {
+	ItemId          string
+	VirtualCurrency string
+	Price           int32
+	CatalogVersion  string `json:",omitempty"`
+	StoreId         string `json:",omitempty"`
+	CharacterId     string `json:",omitempty"`
+}
+
+type PurchaseItemResult struct {
+	Items []ItemInstance
+}
+
+// Client gives access to the player-facing PlayFab Client API. A login
+// returns a Session that authenticates further calls as that player.
+type Client struct {
+	pf *PlayFab
+}
+
+// Client returns a handle on the Client API that shares the transport and
+// retry policy of pf.
+func (pf *PlayFab) Client() *Client {
+	return &Client{pf: pf}
+}
+
+// NewClient creates a Client API handle for tools that act only as players
+// and therefore have no title secret.
+func NewClient(titleId string, opts ...Option) (*Client, error) {
+	if titleId == "" {
+		return nil, fmt.Errorf("titleId is required")
+	}
+	return newPlayFab("", titleId, "", opts...).Client(), nil
+}
+
+func (c *Client) LoginWithCustomID(ctx context.Context, req *LoginWithCustomIDRequest) (*Session, error) {
+	r := *req
+	if r.TitleId == "" {
+		r.TitleId = c.pf.titleId
+	}
+	return c.login(ctx, "LoginWithCustomID", &r)
+}
+
+func (c *Client) LoginWithEmailAddress(ctx context.Context, req *LoginWithEmailAddressRequest) (*Session, error) {
+	r := *req
+	if r.TitleId == "" {
+		r.TitleId = c.pf.titleId
+	}
+	return c.login(ctx, "LoginWithEmailAddress", &r)
+}
+
+func (c *Client) LoginWithAndroidDeviceID(ctx context.Context, req *LoginWithAndroidDeviceIDRequest) (*Session, error) {
+	r := *req
+	if r.TitleId == "" {
+		r.TitleId = c.pf.titleId
+	}
+	return c.login(ctx, "LoginWithAndroidDeviceID", &r)
+}
+
+func (c *Client) LoginWithIOSDeviceID(ctx context.Context, req *LoginWithIOSDeviceIDRequest) (*Session, error) {
+	r := *req
+	if r.TitleId == "" {
+		r.TitleId = c.pf.titleId
+	}
+	return c.login(ctx, "LoginWithIOSDeviceID", &r)
+}
+
+// RegisterPlayFabUser creates a player account and returns its session.
+// The session logs in with the registered email, or username, and password
+// when it needs a new ticket.
+func (c *Client) RegisterPlayFabUser(ctx context.Context, req *RegisterPlayFabUserRequest) (*Session, error) {
+	r := *req
+	if r.TitleId == "" {
+		r.TitleId = c.pf.titleId
+	}
+	res := &RegisterPlayFabUserResult{}
+	if err := c.pf.callAs(ctx, authHeader{}, "Client", "RegisterPlayFabUser", &r, res); err != nil {
+		return nil, err
+	}
+
+	var relogin map[string]interface{}
+	funcName := "LoginWithPlayFab"
+	if r.Email != "" {
+		funcName = "LoginWithEmailAddress"
+		relogin = map[string]interface{}{"TitleId": r.TitleId, "Email": r.Email, "Password": r.Password}
+	} else {
+		relogin = map[string]interface{}{"TitleId": r.TitleId, "Username": r.Username, "Password": r.Password}
+	}
+	s := c.newSession(funcName, relogin)
+	s.update(&LoginResult{
+		PlayFabId:     res.PlayFabId,
+		SessionTicket: res.SessionTicket,
+		NewlyCreated:  true,
+		EntityToken:   res.EntityToken,
+	})
+	return s, nil
+}
+
+func (c *Client) login(ctx context.Context, funcName string, req interface{}) (*Session, error) {
+	s := c.newSession(funcName, req)
+	if err := s.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) newSession(funcName string, req interface{}) *Session {
+	s := &Session{
+		client: c,
+		login: func(ctx context.Context) (*LoginResult, error) {
+			res := &LoginResult{}
+			if err := c.pf.callAs(ctx, authHeader{}, "Client", funcName, req, res); err != nil {
+				return nil, err
+			}
+			return res, nil
+		},
+	}
+	s.entityToken = &entityTokenCache{fetch: s.fetchEntityToken}
+	return s
+}
+
+// Session is a logged in player. It sends the session ticket as
+// X-Authorization and logs in again when the ticket expires or PlayFab
+// rejects it.
+type Session struct {
+	client      *Client
+	login       func(ctx context.Context) (*LoginResult, error)
+	entityToken *entityTokenCache
+
+	// refreshMu lets a single login run at a time. Calls that find the
+	// ticket expired or rejected wait for it instead of logging in as well.
+	refreshMu sync.Mutex
+
+	mu      sync.Mutex
+	result  *LoginResult
+	expires time.Time
+}
+
+func (s *Session) PlayFabId() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.result.PlayFabId
+}
+
+func (s *Session) SessionTicket() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.result.SessionTicket
+}
+
+// LoginResult returns the result of the latest login of the session.
+func (s *Session) LoginResult() *LoginResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.result
+}
+
+// Refresh logs in again and replaces the session ticket.
+func (s *Session) Refresh(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+	return s.relogin(ctx)
+}
+
+// refresh logs in again unless the ticket was replaced after stale, the
+// ticket a call found expired or rejected, while waiting for another login.
+func (s *Session) refresh(ctx context.Context, stale string) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+	s.mu.Lock()
+	current := s.result != nil && s.result.SessionTicket != stale && time.Now().Before(s.expires)
+	s.mu.Unlock()
+	if current {
+		return nil
+	}
+	return s.relogin(ctx)
+}
+
+// relogin must be called with refreshMu held.
+func (s *Session) relogin(ctx context.Context) error {
+	res, err := s.login(ctx)
+	if err != nil {
+		return err
+	}
+	s.update(res)
+	return nil
+}
+
+func (s *Session) update(res *LoginResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.result = res
+	s.expires = time.Now().Add(sessionTicketLifetime - sessionRefreshMargin)
+}
+
+func (s *Session) ticket(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	ticket := s.result.SessionTicket
+	expired := time.Now().After(s.expires)
+	s.mu.Unlock()
+	if expired {
+		if err := s.refresh(ctx, ticket); err != nil {
+			return "", err
+		}
+	}
+	return s.SessionTicket(), nil
+}
+
+// Call sends req to a Client API function as this player and decodes the
+// data of the response into out, which may be nil.
+func (s *Session) Call(ctx context.Context, funcName string, req interface{}, out interface{}) error {
+	ticket, err := s.ticket(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.client.pf.callAs(ctx, authHeader{"X-Authorization", ticket}, "Client", funcName, req, out)
+	if !IsErrorCode(err, ErrInvalidSessionTicket, ErrNotAuthenticated) {
+		return err
+	}
+	if err := s.refresh(ctx, ticket); err != nil {
+		return err
+	}
+	return s.client.pf.callAs(ctx, authHeader{"X-Authorization", s.SessionTicket()}, "Client", funcName, req, out)
+}
+
+// Entity returns a handle that calls the entity APIs as this player, with
+// the entity token of the latest login.
+func (s *Session) Entity() *Entity {
+	return &Entity{pf: s.client.pf, tokens: s.entityToken}
+}
+
+// fetchEntityToken returns the entity token of the latest login, logging in
+// again when that is the stale token or is about to expire.
+func (s *Session) fetchEntityToken(ctx context.Context, stale *EntityTokenResponse) (*EntityTokenResponse, error) {
+	if tok := s.LoginResult().EntityToken; tok != nil && tok != stale && !tokenExpiring(tok) {
+		return tok, nil
+	}
+	if err := s.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	return s.LoginResult().EntityToken, nil
+}
+
+// PurchaseItem buys an item for the player with virtual currency through the
+// Client API, which checks the price and debits and grants atomically.
+func (s *Session) PurchaseItem(ctx context.Context, req *PurchaseItemRequest) (*PurchaseItemResult, error) {
+	r := *req
+	r.CatalogVersion = s.client.pf.catalog(r.CatalogVersion)
+	res := &PurchaseItemResult{}
+	if err := s.Call(ctx, "PurchaseItem", &r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
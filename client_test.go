@@ -0,0 +1,126 @@
+package playfab_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func newSession(t *testing.T, srv *playfabtest.Server) *playfab.Session {
+	t.Helper()
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+	s, err := pf.Client().LoginWithCustomID(context.Background(), &playfab.LoginWithCustomIDRequest{
+		CustomId:      "bot-1",
+		CreateAccount: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func getInventory(s *playfab.Session) error {
+	return s.Call(context.Background(), "GetUserInventory", struct{}{}, &playfab.GetUserInventoryResult{})
+}
+
+func TestSessionRefresh(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	s := newSession(t, srv)
+	ticket := s.SessionTicket()
+
+	if err := s.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if s.SessionTicket() == ticket {
+		t.Error("Refresh kept the old ticket")
+	}
+	if err := getInventory(s); err != nil {
+		t.Fatal(err)
+	}
+	if n := srv.Calls("LoginWithCustomID"); n != 2 {
+		t.Errorf("got %d logins, want 2", n)
+	}
+}
+
+func TestSessionRetriesRejectedTicket(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	s := newSession(t, srv)
+	srv.InvalidateSessionTickets()
+
+	if err := getInventory(s); err != nil {
+		t.Fatal(err)
+	}
+	if n := srv.Calls("LoginWithCustomID"); n != 2 {
+		t.Errorf("got %d logins, want 2", n)
+	}
+	if n := srv.Calls("GetUserInventory"); n != 2 {
+		t.Errorf("got %d calls, want the rejected one and its retry", n)
+	}
+}
+
+func TestSessionLogsInOnceForConcurrentCalls(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		expire func(srv *playfabtest.Server, s *playfab.Session)
+	}{
+		{"expired", func(_ *playfabtest.Server, s *playfab.Session) { playfab.ExpireSession(s) }},
+		{"rejected", func(srv *playfabtest.Server, _ *playfab.Session) { srv.InvalidateSessionTickets() }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := playfabtest.NewServer()
+			defer srv.Close()
+			s := newSession(t, srv)
+			tc.expire(srv, s)
+
+			var wg sync.WaitGroup
+			errs := make(chan error, 10)
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					errs <- getInventory(s)
+				}()
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				if err != nil {
+					t.Error(err)
+				}
+			}
+			if n := srv.Calls("LoginWithCustomID"); n != 2 {
+				t.Errorf("got %d logins, want the first and one refresh", n)
+			}
+		})
+	}
+}
+
+func TestRegisteredSessionLogsInAgain(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+	s, err := pf.Client().RegisterPlayFabUser(context.Background(), &playfab.RegisterPlayFabUserRequest{
+		Email:    "bot@example.com",
+		Password: "hunter22",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	playFabId := s.PlayFabId()
+	srv.InvalidateSessionTickets()
+
+	if err := getInventory(s); err != nil {
+		t.Fatal(err)
+	}
+	if n := srv.Calls("LoginWithEmailAddress"); n != 1 {
+		t.Errorf("got %d email logins, want 1", n)
+	}
+	if s.PlayFabId() != playFabId || s.LoginResult().NewlyCreated {
+		t.Errorf("got %+v, want a login to the registered account", s.LoginResult())
+	}
+}
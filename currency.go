@@ -0,0 +1,39 @@
+package playfab
+
+import "context"
+
+type VirtualCurrencyBalances struct {
+	VirtualCurrency              map[string]int32
+	VirtualCurrencyRechargeTimes map[string]VirtualCurrencyRechargeTime
+}
+
+// GetVirtualCurrencyBalances reads the player's balances and recharge times
+// without downloading the inventory.
+func (pf *PlayFab) GetVirtualCurrencyBalances(ctx context.Context, playFabId string) (*VirtualCurrencyBalances, error) {
+	res, err := pf.GetPlayerCombinedInfoTyped(ctx, &GetPlayerCombinedInfoRequest{
+		PlayFabId: playFabId,
+		InfoRequestParameters: GetPlayerCombinedInfoRequestParams{
+			GetUserVirtualCurrency: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	balances := &VirtualCurrencyBalances{
+		VirtualCurrency:              res.InfoResultPayload.UserVirtualCurrency,
+		VirtualCurrencyRechargeTimes: res.InfoResultPayload.UserVirtualCurrencyRechargeTimes,
+	}
+	if balances.VirtualCurrency == nil {
+		balances.VirtualCurrency = make(map[string]int32)
+	}
+	if balances.VirtualCurrencyRechargeTimes == nil {
+		balances.VirtualCurrencyRechargeTimes = make(map[string]VirtualCurrencyRechargeTime)
+	}
+	return balances, nil
+}
+
+// GetInventoryAndCurrency reads the player's inventory together with the
+// balances and recharge times in a single call.
+func (pf *PlayFab) GetInventoryAndCurrency(ctx context.Context, playFabId string) (*GetUserInventoryResult, error) {
+	return pf.GetUserInventoryTyped(ctx, &GetUserInventoryRequest{PlayFabId: playFabId})
+}
@@ -0,0 +1,87 @@
+package playfab_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func TestGetVirtualCurrencyBalances(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetCatalog("main", playfab.CatalogItem{ItemId: "sword"})
+	srv.SetVirtualCurrency("player", "GO", 7)
+	srv.SetVirtualCurrency("player", "GE", 3)
+	srv.AddInventoryItems("player", playfab.ItemInstance{ItemId: "sword"})
+	pf, _ := srv.NewClient("main")
+
+	balances, err := pf.GetVirtualCurrencyBalances(context.Background(), "player")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(balances.VirtualCurrency) != 2 || balances.VirtualCurrency["GO"] != 7 || balances.VirtualCurrency["GE"] != 3 {
+		t.Errorf("got %v", balances.VirtualCurrency)
+	}
+	if balances.VirtualCurrencyRechargeTimes == nil {
+		t.Error("got nil recharge times")
+	}
+
+	// Only the balances are asked for, and the inventory is not read.
+	if n := srv.Calls("GetUserInventory"); n != 0 {
+		t.Errorf("GetUserInventory called %d times", n)
+	}
+	var req playfab.GetPlayerCombinedInfoRequest
+	if err := json.Unmarshal(srv.Requests("GetPlayerCombinedInfo")[0], &req); err != nil {
+		t.Fatal(err)
+	}
+	want := playfab.GetPlayerCombinedInfoRequestParams{GetUserVirtualCurrency: true}
+	if req.PlayFabId != "player" || !reflect.DeepEqual(req.InfoRequestParameters, want) {
+		t.Errorf("sent %+v", req)
+	}
+}
+
+func TestGetVirtualCurrencyBalancesEmpty(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddPlayer("player")
+	pf, _ := srv.NewClient("main")
+
+	balances, err := pf.GetVirtualCurrencyBalances(context.Background(), "player")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balances.VirtualCurrency == nil || len(balances.VirtualCurrency) != 0 || balances.VirtualCurrencyRechargeTimes == nil {
+		t.Errorf("got %+v, want empty maps", balances)
+	}
+
+	if _, err := pf.GetVirtualCurrencyBalances(context.Background(), "nobody"); !playfab.IsErrorCode(err, playfab.ErrAccountNotFound) {
+		t.Errorf("got %v, want AccountNotFound", err)
+	}
+}
+
+func TestGetInventoryAndCurrency(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetCatalog("main", playfab.CatalogItem{ItemId: "sword"})
+	srv.SetVirtualCurrency("player", "GO", 7)
+	srv.AddInventoryItems("player", playfab.ItemInstance{ItemId: "sword"})
+	pf, _ := srv.NewClient("main")
+
+	res, err := pf.GetInventoryAndCurrency(context.Background(), "player")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Inventory) != 1 || res.Inventory[0].ItemId != "sword" {
+		t.Errorf("got inventory %+v", res.Inventory)
+	}
+	if res.VirtualCurrency["GO"] != 7 || res.VirtualCurrencyRechargeTimes == nil {
+		t.Errorf("got %v, %v", res.VirtualCurrency, res.VirtualCurrencyRechargeTimes)
+	}
+	if n := srv.Calls("GetUserInventory"); n != 1 {
+		t.Errorf("GetUserInventory called %d times, want 1", n)
+	}
+}
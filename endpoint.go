@@ -0,0 +1,39 @@
+package playfab
+
+import (
+	"fmt"
+	"strings"
+)
+
+const url = "https://%s.playfabapi.com/%s/%s"
+
+// EndpointResolver builds the URL a PlayFab function is called at, such as
+// https://{titleId}.playfabapi.com/Server/GetUserInventory.
+type EndpointResolver interface {
+	ResolveEndpoint(titleId string, api string, funcName string) string
+}
+
+type EndpointResolverFunc func(titleId string, api string, funcName string) string
+
+func (f EndpointResolverFunc) ResolveEndpoint(titleId string, api string, funcName string) string {
+	return f(titleId, api, funcName)
+}
+
+var defaultEndpoints = EndpointResolverFunc(func(titleId string, api string, funcName string) string {
+	return fmt.Sprintf(url, titleId, api, funcName)
+})
+
+func WithEndpointResolver(resolver EndpointResolver) Option {
+	return func(pf *PlayFab) {
+		pf.endpoints = resolver
+	}
+}
+
+// WithBaseURL sends every call to {baseURL}/{api}/{funcName}, for example to
+// a local emulator at http://localhost:8080 or a recording proxy.
+func WithBaseURL(baseURL string) Option {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return WithEndpointResolver(EndpointResolverFunc(func(titleId string, api string, funcName string) string {
+		return baseURL + "/" + api + "/" + funcName
+	}))
+}
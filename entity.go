@@ -0,0 +1,115 @@
+package playfab
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// entityTokenRefreshMargin is how long before TokenExpiration a cached
+// entity token is replaced.
+const entityTokenRefreshMargin = 5 * time.Minute
+
+type EntityKey struct {
+	Id   string
+	Type string `json:",omitempty"`
+}
+
+type EntityTokenResponse struct {
+	Entity          *EntityKey `json:",omitempty"`
+	EntityToken     string
+	TokenExpiration *time.Time `json:",omitempty"`
+}
+
+type GetEntityTokenRequest struct {
+	Entity     *EntityKey        `json:",omitempty"`
+	CustomTags map[string]string `json:",omitempty"`
+}
+
+// GetEntityToken gets an entity token with the title secret. Without an
+// Entity in req the token is for the title entity.
+func (pf *PlayFab) GetEntityToken(ctx context.Context, req *GetEntityTokenRequest) (*EntityTokenResponse, error) {
+	res := &EntityTokenResponse{}
+	if err := pf.call(ctx, "Authentication", "GetEntityToken", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TitleEntity returns a handle that calls the entity APIs as the title. Its
+// token is fetched on first use, shared by every handle of pf and replaced
+// before it expires.
+func (pf *PlayFab) TitleEntity() *Entity {
+	return &Entity{pf: pf, tokens: pf.titleToken}
+}
+
+// Entity calls the entity based APIs, such as Data, Groups, CloudScript,
+// Economy and Events, sending an entity token as X-EntityToken.
+type Entity struct {
+	pf     *PlayFab
+	tokens *entityTokenCache
+}
+
+// Key returns the key of the entity the token belongs to.
+func (e *Entity) Key(ctx context.Context) (*EntityKey, error) {
+	tok, err := e.tokens.get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if tok.Entity == nil {
+		return nil, fmt.Errorf("entity token has no entity")
+	}
+	return tok.Entity, nil
+}
+
+// Call sends req to funcName of an entity API, such as "CloudScript" or
+// "Group", and decodes the data of the response into out, which may be nil.
+// A rejected token is replaced once and the call repeated.
+func (e *Entity) Call(ctx context.Context, api string, funcName string, req interface{}, out interface{}) error {
+	tok, err := e.tokens.get(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = e.pf.callAs(ctx, authHeader{"X-EntityToken", tok.EntityToken}, api, funcName, req, out)
+	if !IsErrorCode(err, ErrEntityTokenExpired, ErrEntityTokenInvalid, ErrEntityTokenRevoked, ErrNotAuthenticated) {
+		return err
+	}
+	if tok, err = e.tokens.get(ctx, tok); err != nil {
+		return err
+	}
+	return e.pf.callAs(ctx, authHeader{"X-EntityToken", tok.EntityToken}, api, funcName, req, out)
+}
+
+// entityTokenCache holds an entity token and fetches a new one when it is
+// missing, about to expire or known to be rejected. fetch is given the token
+// being replaced, if any.
+type entityTokenCache struct {
+	fetch func(ctx context.Context, stale *EntityTokenResponse) (*EntityTokenResponse, error)
+
+	mu  sync.Mutex
+	tok *EntityTokenResponse
+}
+
+// get returns the cached token unless it is rejected, the token a call
+// failed with, or about to expire.
+func (c *entityTokenCache) get(ctx context.Context, rejected *EntityTokenResponse) (*EntityTokenResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.tok != nil && c.tok != rejected && !tokenExpiring(c.tok) {
+		return c.tok, nil
+	}
+	tok, err := c.fetch(ctx, c.tok)
+	if err != nil {
+		return nil, err
+	}
+	if tok == nil || tok.EntityToken == "" {
+		return nil, fmt.Errorf("no entity token")
+	}
+	c.tok = tok
+	return tok, nil
+}
+
+func tokenExpiring(tok *EntityTokenResponse) bool {
+	return tok.TokenExpiration != nil && time.Now().Add(entityTokenRefreshMargin).After(*tok.TokenExpiration)
+}
@@ -0,0 +1,103 @@
+package playfab_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func getProfile(e *playfab.Entity) (*playfab.EntityKey, error) {
+	var res struct {
+		Profile struct {
+			Entity *playfab.EntityKey
+		}
+	}
+	if err := e.Call(context.Background(), "Profile", "GetProfile", struct{}{}, &res); err != nil {
+		return nil, err
+	}
+	return res.Profile.Entity, nil
+}
+
+func TestTitleEntityCachesToken(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+
+	for i := 0; i < 3; i++ {
+		key, err := getProfile(pf.TitleEntity())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key.Type != "title" || key.Id != srv.TitleId {
+			t.Errorf("got %+v, want the title entity", key)
+		}
+	}
+	if n := srv.Calls("GetEntityToken"); n != 1 {
+		t.Errorf("got %d token fetches, want 1", n)
+	}
+}
+
+func TestTitleEntityReplacesExpiringToken(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	// Tokens that expire within the refresh margin are replaced on each use.
+	srv.EntityTokenLifetime = time.Minute
+	pf, _ := srv.NewClient("main")
+
+	for i := 0; i < 2; i++ {
+		if _, err := getProfile(pf.TitleEntity()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := srv.Calls("GetEntityToken"); n != 2 {
+		t.Errorf("got %d token fetches, want 2", n)
+	}
+}
+
+func TestTitleEntityReplacesRejectedToken(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+	if _, err := getProfile(pf.TitleEntity()); err != nil {
+		t.Fatal(err)
+	}
+	srv.ExpireEntityTokens()
+
+	if _, err := getProfile(pf.TitleEntity()); err != nil {
+		t.Fatal(err)
+	}
+	if n := srv.Calls("GetEntityToken"); n != 2 {
+		t.Errorf("got %d token fetches, want 2", n)
+	}
+	if n := srv.Calls("GetProfile"); n != 3 {
+		t.Errorf("got %d calls, want the rejected one repeated", n)
+	}
+}
+
+func TestSessionEntity(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	s := newSession(t, srv)
+
+	key, err := s.Entity().Key(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Type != "title_player_account" || key.Id != s.PlayFabId() {
+		t.Errorf("got %+v, want the player's entity", key)
+	}
+	srv.ExpireEntityTokens()
+
+	if _, err := getProfile(s.Entity()); err != nil {
+		t.Fatal(err)
+	}
+	if n := srv.Calls("LoginWithCustomID"); n != 2 {
+		t.Errorf("got %d logins, want a new login for the rejected token", n)
+	}
+	if n := srv.Calls("GetEntityToken"); n != 0 {
+		t.Errorf("got %d token fetches, want the login's token used", n)
+	}
+}
@@ -0,0 +1,206 @@
+package playfab
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const ErrUnknown int = 99
+
+type PlayFabError struct {
+	originError  error
+	Body         []byte
+	Method       string
+	RespCode     int
+	ErrorCode    int
+	ErrorMsg     string
+	ErrorDetails string
+	// Status is the HTTP status name PlayFab reports, such as "Conflict".
+	Status string
+	// Details maps each offending request field to its validation messages.
+	Details    map[string][]string
+	RetryAfter time.Duration
+}
+
+func (e *PlayFabError) Error() string {
+	if e.originError == nil {
+		return fmt.Sprintf("%s - %d: %s", e.Method, e.ErrorCode, e.ErrorMsg)
+	}
+	return fmt.Sprintf("%s - %s", e.Method, e.originError.Error())
+}
+
+func (e *PlayFabError) Unwrap() error {
+	return e.originError
+}
+
+// Is reports whether e carries the PlayFab error code target, so that
+// errors.Is(err, playfab.ErrInsufficientFunds) matches.
+func (e *PlayFabError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && e.ErrorCode == int(code)
+}
+
+func (e *PlayFabError) Code() ErrorCode {
+	return ErrorCode(e.ErrorCode)
+}
+
+// ErrorCode is one of the error codes documented in the PlayFab API reference.
+// Each code is also an error, to be used as a target for errors.Is.
+type ErrorCode int
+
+const (
+	ErrInvalidParams                     ErrorCode = 1000
+	ErrAccountNotFound                   ErrorCode = 1001
+	ErrAccountBanned                     ErrorCode = 1002
+	ErrInvalidUsernameOrPassword         ErrorCode = 1003
+	ErrInvalidTitleId                    ErrorCode = 1004
+	ErrInvalidEmailAddress               ErrorCode = 1005
+	ErrEmailAddressNotAvailable          ErrorCode = 1006
+	ErrInvalidUsername                   ErrorCode = 1007
+	ErrInvalidPassword                   ErrorCode = 1008
+	ErrUsernameNotAvailable              ErrorCode = 1009
+	ErrAccountNotLinked                  ErrorCode = 1014
+	ErrCouponCodeNotFound                ErrorCode = 1016
+	ErrInvalidContainerItem              ErrorCode = 1017
+	ErrContainerNotOwned                 ErrorCode = 1018
+	ErrKeyNotOwned                       ErrorCode = 1019
+	ErrItemNotFound                      ErrorCode = 1047
+	ErrItemNotOwned                      ErrorCode = 1048
+	ErrInvalidVirtualCurrency            ErrorCode = 1051
+	ErrWrongVirtualCurrency              ErrorCode = 1052
+	ErrWrongPrice                        ErrorCode = 1053
+	ErrInsufficientFunds                 ErrorCode = 1059
+	ErrNoRemainingUses                   ErrorCode = 1062
+	ErrNotAuthenticated                  ErrorCode = 1074
+	ErrNotAuthorized                     ErrorCode = 1089
+	ErrInvalidSessionTicket              ErrorCode = 1100
+	ErrServiceUnavailable                ErrorCode = 1123
+	ErrAPIRequestLimitExceeded           ErrorCode = 1130
+	ErrConcurrentEditError               ErrorCode = 1133
+	ErrCharacterNotFound                 ErrorCode = 1135
+	ErrDataLengthExceeded                ErrorCode = 1146
+	ErrTooManyKeys                       ErrorCode = 1147
+	ErrStatisticNotFound                 ErrorCode = 1195
+	ErrStatisticVersionClosedForWrites   ErrorCode = 1197
+	ErrStatisticVersionInvalid           ErrorCode = 1198
+	ErrAPIClientRequestRateLimitExceeded ErrorCode = 1199
+	ErrStoreNotFound                     ErrorCode = 1221
+	ErrCouponAlreadyRedeemed             ErrorCode = 1226
+	ErrDataUpdateRateExceeded            ErrorCode = 1287
+	ErrEntityTokenMissing                ErrorCode = 1331
+	ErrEntityTokenInvalid                ErrorCode = 1332
+	ErrEntityTokenExpired                ErrorCode = 1333
+	ErrEntityTokenRevoked                ErrorCode = 1334
+)
+
+var errorCodeNames = map[ErrorCode]string{
+	ErrInvalidParams:                     "InvalidParams",
+	ErrAccountNotFound:                   "AccountNotFound",
+	ErrAccountBanned:                     "AccountBanned",
+	ErrInvalidUsernameOrPassword:         "InvalidUsernameOrPassword",
+	ErrInvalidTitleId:                    "InvalidTitleId",
+	ErrInvalidEmailAddress:               "InvalidEmailAddress",
+	ErrEmailAddressNotAvailable:          "EmailAddressNotAvailable",
+	ErrInvalidUsername:                   "InvalidUsername",
+	ErrInvalidPassword:                   "InvalidPassword",
+	ErrUsernameNotAvailable:              "UsernameNotAvailable",
+	ErrAccountNotLinked:                  "AccountNotLinked",
+	ErrCouponCodeNotFound:                "CouponCodeNotFound",
+	ErrInvalidContainerItem:              "InvalidContainerItem",
+	ErrContainerNotOwned:                 "ContainerNotOwned",
+	ErrKeyNotOwned:                       "KeyNotOwned",
+	ErrItemNotFound:                      "ItemNotFound",
+	ErrItemNotOwned:                      "ItemNotOwned",
+	ErrInvalidVirtualCurrency:            "InvalidVirtualCurrency",
+	ErrWrongVirtualCurrency:              "WrongVirtualCurrency",
+	ErrWrongPrice:                        "WrongPrice",
+	ErrInsufficientFunds:                 "InsufficientFunds",
+	ErrNoRemainingUses:                   "NoRemainingUses",
+	ErrNotAuthenticated:                  "NotAuthenticated",
+	ErrNotAuthorized:                     "NotAuthorized",
+	ErrInvalidSessionTicket:              "InvalidSessionTicket",
+	ErrServiceUnavailable:                "ServiceUnavailable",
+	ErrAPIRequestLimitExceeded:           "APIRequestLimitExceeded",
+	ErrConcurrentEditError:               "ConcurrentEditError",
+	ErrCharacterNotFound:                 "CharacterNotFound",
+	ErrDataLengthExceeded:                "DataLengthExceeded",
+	ErrTooManyKeys:                       "TooManyKeys",
+	ErrStatisticNotFound:                 "StatisticNotFound",
+	ErrStatisticVersionClosedForWrites:   "StatisticVersionClosedForWrites",
+	ErrStatisticVersionInvalid:           "StatisticVersionInvalid",
+	ErrAPIClientRequestRateLimitExceeded: "APIClientRequestRateLimitExceeded",
+	ErrStoreNotFound:                     "StoreNotFound",
+	ErrCouponAlreadyRedeemed:             "CouponAlreadyRedeemed",
+	ErrDataUpdateRateExceeded:            "DataUpdateRateExceeded",
+	ErrEntityTokenMissing:                "EntityTokenMissing",
+	ErrEntityTokenInvalid:                "EntityTokenInvalid",
+	ErrEntityTokenExpired:                "EntityTokenExpired",
+	ErrEntityTokenRevoked:                "EntityTokenRevoked",
+}
+
+func (c ErrorCode) Error() string {
+	return "playfab: " + c.String()
+}
+
+func (c ErrorCode) String() string {
+	if name, ok := errorCodeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("ErrorCode(%d)", int(c))
+}
+
+// IsErrorCode reports whether err is a PlayFab error with one of the given codes.
+func IsErrorCode(err error, codes ...ErrorCode) bool {
+	var pfErr *PlayFabError
+	if !errors.As(err, &pfErr) {
+		return false
+	}
+	for _, code := range codes {
+		if pfErr.ErrorCode == int(code) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseErrorDetails decodes the errorDetails object of an error response,
+// which maps request field names to a list of messages.
+func parseErrorDetails(raw interface{}) map[string][]string {
+	fields, ok := raw.(map[string]interface{})
+	if !ok || len(fields) == 0 {
+		return nil
+	}
+	details := make(map[string][]string, len(fields))
+	for field, v := range fields {
+		switch msgs := v.(type) {
+		case []interface{}:
+			for _, msg := range msgs {
+				if s, ok := msg.(string); ok {
+					details[field] = append(details[field], s)
+				}
+			}
+		case string:
+			details[field] = []string{msgs}
+		}
+	}
+	return details
+}
+
+func formatErrorDetails(details map[string][]string) string {
+	if len(details) == 0 {
+		return ""
+	}
+	fields := make([]string, 0, len(details))
+	for field := range details {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		parts = append(parts, field+": "+strings.Join(details[field], ", "))
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,77 @@
+package playfab_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func TestErrorCodes(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+
+	_, err := pf.GetUserInventoryTyped(context.Background(), &playfab.GetUserInventoryRequest{PlayFabId: "nobody"})
+	if !errors.Is(err, playfab.ErrAccountNotFound) {
+		t.Errorf("got %v, want ErrAccountNotFound", err)
+	}
+	if errors.Is(err, playfab.ErrItemNotFound) {
+		t.Error("error matches ErrItemNotFound")
+	}
+	if !playfab.IsErrorCode(err, playfab.ErrItemNotFound, playfab.ErrAccountNotFound) {
+		t.Error("IsErrorCode does not match ErrAccountNotFound")
+	}
+	var pfErr *playfab.PlayFabError
+	if !errors.As(err, &pfErr) || pfErr.Code() != playfab.ErrAccountNotFound || pfErr.RespCode != http.StatusBadRequest {
+		t.Errorf("got %#v", err)
+	}
+}
+
+func TestErrorDetails(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddPlayer("player")
+	srv.FailNext("AddUserVirtualCurrency", playfabtest.Failure{
+		ErrorCode: playfab.ErrInvalidParams,
+		Message:   "Invalid input parameters",
+		Details:   map[string][]string{"Amount": {"must be positive", "must be an integer"}},
+	})
+	pf, _ := srv.NewClient("main")
+
+	_, err := pf.AddUserVirtualCurrencyTyped(context.Background(), &playfab.AddUserVirtualCurrencyRequest{
+		PlayFabId:       "player",
+		VirtualCurrency: "GO",
+		Amount:          -1,
+	})
+	var pfErr *playfab.PlayFabError
+	if !errors.As(err, &pfErr) {
+		t.Fatalf("got %v, want a PlayFabError", err)
+	}
+	if !errors.Is(err, playfab.ErrInvalidParams) || pfErr.Status != "BadRequest" || pfErr.ErrorMsg != "Invalid input parameters" {
+		t.Errorf("got %#v", pfErr)
+	}
+	if msgs := pfErr.Details["Amount"]; len(msgs) != 2 || msgs[0] != "must be positive" {
+		t.Errorf("got details %v", pfErr.Details)
+	}
+}
+
+func TestErrorFromGateway(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddPlayer("player")
+	srv.FailNext("GetUserInventory", playfabtest.ServiceUnavailable)
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+
+	_, err := pf.GetUserInventoryTyped(context.Background(), &playfab.GetUserInventoryRequest{PlayFabId: "player"})
+	var pfErr *playfab.PlayFabError
+	if !errors.As(err, &pfErr) || pfErr.ErrorCode != playfab.ErrUnknown || pfErr.RespCode != http.StatusServiceUnavailable {
+		t.Errorf("got %#v", err)
+	}
+	if !playfab.IsRetryable(err) {
+		t.Error("a 503 from a gateway is not retryable")
+	}
+}
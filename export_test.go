@@ -0,0 +1,11 @@
+package playfab
+
+import "time"
+
+// ExpireSession makes the ticket of s count as expired, as it does a day
+// after the login.
+func ExpireSession(s *Session) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expires = time.Now().Add(-time.Second)
+}
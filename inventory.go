@@ -0,0 +1,208 @@
+package playfab
+
+import (
+	"context"
+	"fmt"
+)
+
+type ModifyItemUsesRequest struct {
+	PlayFabId      string
+	ItemInstanceId string
+	// UsesToAdd may be negative to take uses away.
+	UsesToAdd int32
+}
+
+type ModifyItemUsesResult struct {
+	ItemInstanceId string
+	RemainingUses  int32
+}
+
+type UpdateUserInventoryItemDataRequest struct {
+	PlayFabId      string
+	ItemInstanceId string
+	CharacterId    string            `json:",omitempty"`
+	Data           map[string]string `json:",omitempty"`
+	KeysToRemove   []string          `json:",omitempty"`
+}
+
+type MoveItemRequest struct {
+	PlayFabId      string
+	CharacterId    string
+	ItemInstanceId string
+}
+
+type ItemGrant struct {
+	PlayFabId    string
+	ItemId       string
+	CharacterId  string            `json:",omitempty"`
+	Annotation   string            `json:",omitempty"`
+	Data         map[string]string `json:",omitempty"`
+	KeysToRemove []string          `json:",omitempty"`
+}
+
+type GrantItemsToUsersRequest struct {
+	CatalogVersion string `json:",omitempty"`
+	ItemGrants     []ItemGrant
+}
+
+type GrantItemsToUsersResult struct {
+	ItemGrantResults []GrantedItemInstance
+}
+
+type UnlockContainerInstanceRequest struct {
+	PlayFabId               string
+	ContainerItemInstanceId string
+	// KeyItemInstanceId picks the key to use when the player has several.
+	KeyItemInstanceId string `json:",omitempty"`
+	CatalogVersion    string `json:",omitempty"`
+	CharacterId       string `json:",omitempty"`
+}
+
+type UnlockContainerItemRequest struct {
+	PlayFabId       string
+	ContainerItemId string
+	CatalogVersion  string `json:",omitempty"`
+	CharacterId     string `json:",omitempty"`
+}
+
+type UnlockContainerItemResult struct {
+	UnlockedItemInstanceId     string
+	UnlockedWithItemInstanceId string            `json:",omitempty"`
+	GrantedItems               []ItemInstance    `json:",omitempty"`
+	VirtualCurrency            map[string]uint32 `json:",omitempty"`
+}
+
+type RedeemCouponRequest struct {
+	PlayFabId      string
+	CouponCode     string
+	CatalogVersion string `json:",omitempty"`
+	CharacterId    string `json:",omitempty"`
+}
+
+type RedeemCouponResult struct {
+	GrantedItems []ItemInstance
+}
+
+func (pf *PlayFab) ModifyItemUses(ctx context.Context, req *ModifyItemUsesRequest) (*ModifyItemUsesResult, error) {
+	res := &ModifyItemUsesResult{}
+	if err := pf.call(ctx, "Server", "ModifyItemUses", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateUserInventoryItemCustomData sets and removes custom data keys of an
+// item instance.
+func (pf *PlayFab) UpdateUserInventoryItemCustomData(ctx context.Context, req *UpdateUserInventoryItemDataRequest) error {
+	return pf.call(ctx, "Server", "UpdateUserInventoryItemCustomData", req, nil)
+}
+
+func (pf *PlayFab) MoveItemToUserFromCharacter(ctx context.Context, req *MoveItemRequest) error {
+	return pf.call(ctx, "Server", "MoveItemToUserFromCharacter", req, nil)
+}
+
+func (pf *PlayFab) MoveItemToCharacterFromUser(ctx context.Context, req *MoveItemRequest) error {
+	return pf.call(ctx, "Server", "MoveItemToCharacterFromUser", req, nil)
+}
+
+// GrantItemsToUsers grants items to several players in one call.
+func (pf *PlayFab) GrantItemsToUsers(ctx context.Context, req *GrantItemsToUsersRequest) (*GrantItemsToUsersResult, error) {
+	r := *req
+	r.CatalogVersion = pf.catalog(r.CatalogVersion)
+	res := &GrantItemsToUsersResult{}
+	if err := pf.call(ctx, "Server", "GrantItemsToUsers", &r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UnlockContainerInstance opens a container instance the player owns, using
+// up a key when the container needs one.
+func (pf *PlayFab) UnlockContainerInstance(ctx context.Context, req *UnlockContainerInstanceRequest) (*UnlockContainerItemResult, error) {
+	r := *req
+	r.CatalogVersion = pf.catalog(r.CatalogVersion)
+	res := &UnlockContainerItemResult{}
+	if err := pf.call(ctx, "Server", "UnlockContainerInstance", &r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UnlockContainerItem opens the first instance of a container item the
+// player owns.
+func (pf *PlayFab) UnlockContainerItem(ctx context.Context, req *UnlockContainerItemRequest) (*UnlockContainerItemResult, error) {
+	r := *req
+	r.CatalogVersion = pf.catalog(r.CatalogVersion)
+	res := &UnlockContainerItemResult{}
+	if err := pf.call(ctx, "Server", "UnlockContainerItem", &r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) RedeemCoupon(ctx context.Context, req *RedeemCouponRequest) (*RedeemCouponResult, error) {
+	r := *req
+	r.CatalogVersion = pf.catalog(r.CatalogVersion)
+	res := &RedeemCouponResult{}
+	if err := pf.call(ctx, "Server", "RedeemCoupon", &r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConsumeItemsByItemId consumes count uses of a catalog item from the
+// player's inventory, across as many instances as needed. Nothing is consumed
+// when the player has fewer uses in total. When a consume fails midway the
+// results of the ones already made are returned with the error. count must
+// be positive.
+func (pf *PlayFab) ConsumeItemsByItemId(ctx context.Context, playFabId string, itemId string, count int32) ([]ConsumeItemResult, error) {
+	if count <= 0 {
+		return nil, &PlayFabError{
+			Method:    "ConsumeItem",
+			ErrorCode: int(ErrInvalidParams),
+			ErrorMsg:  fmt.Sprintf("cannot consume %d uses of %s", count, itemId),
+		}
+	}
+	inv, err := pf.GetUserInventoryTyped(ctx, &GetUserInventoryRequest{PlayFabId: playFabId})
+	if err != nil {
+		return nil, err
+	}
+
+	var stacks []ItemInstance
+	var available int32
+	for _, item := range inv.Inventory {
+		if item.ItemId == itemId && item.RemainingUses != nil && *item.RemainingUses > 0 {
+			stacks = append(stacks, item)
+			available += *item.RemainingUses
+		}
+	}
+	if available < count {
+		return nil, &PlayFabError{
+			Method:    "ConsumeItem",
+			ErrorCode: int(ErrNoRemainingUses),
+			ErrorMsg:  fmt.Sprintf("%s has %d uses left, %d requested", itemId, available, count),
+		}
+	}
+
+	var results []ConsumeItemResult
+	for _, item := range stacks {
+		if count == 0 {
+			break
+		}
+		n := *item.RemainingUses
+		if n > count {
+			n = count
+		}
+		res, err := pf.ConsumeItemTyped(ctx, &ConsumeItemRequest{
+			PlayFabId:      playFabId,
+			ItemInstanceId: item.ItemInstanceId,
+			ConsumeCount:   n,
+		})
+		if err != nil {
+			return results, err
+		}
+		results = append(results, *res)
+		count -= n
+	}
+	return results, nil
+}
@@ -0,0 +1,294 @@
+package playfab_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func TestModifyItemUses(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddInventoryItems("player", playfab.ItemInstance{ItemInstanceId: "gems", ItemId: "gem", RemainingUses: int32p(3)})
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	res, err := pf.ModifyItemUses(ctx, &playfab.ModifyItemUsesRequest{PlayFabId: "player", ItemInstanceId: "gems", UsesToAdd: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ItemInstanceId != "gems" || res.RemainingUses != 7 {
+		t.Errorf("got %+v, want 7 uses", res)
+	}
+
+	res, err = pf.ModifyItemUses(ctx, &playfab.ModifyItemUsesRequest{PlayFabId: "player", ItemInstanceId: "gems", UsesToAdd: -2})
+	if err != nil || res.RemainingUses != 5 {
+		t.Fatalf("got %+v, %v, want 5 uses", res, err)
+	}
+	if uses := *srv.Inventory("player")[0].RemainingUses; uses != 5 {
+		t.Errorf("gem has %d uses, want 5", uses)
+	}
+
+	_, err = pf.ModifyItemUses(ctx, &playfab.ModifyItemUsesRequest{PlayFabId: "player", ItemInstanceId: "gems", UsesToAdd: -6})
+	if !errors.Is(err, playfab.ErrNoRemainingUses) {
+		t.Errorf("got %v, want ErrNoRemainingUses", err)
+	}
+	_, err = pf.ModifyItemUses(ctx, &playfab.ModifyItemUsesRequest{PlayFabId: "player", ItemInstanceId: "nope", UsesToAdd: 1})
+	if !errors.Is(err, playfab.ErrItemNotFound) {
+		t.Errorf("got %v, want ErrItemNotFound", err)
+	}
+}
+
+func TestUpdateUserInventoryItemCustomData(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddInventoryItems("player", playfab.ItemInstance{
+		ItemInstanceId: "sword",
+		ItemId:         "sword",
+		CustomData:     map[string]string{"color": "red", "owner": "bob"},
+	})
+	pf, _ := srv.NewClient("main")
+
+	err := pf.UpdateUserInventoryItemCustomData(context.Background(), &playfab.UpdateUserInventoryItemDataRequest{
+		PlayFabId:      "player",
+		ItemInstanceId: "sword",
+		Data:           map[string]string{"color": "blue", "level": "2"},
+		KeysToRemove:   []string{"owner"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := srv.Inventory("player")[0].CustomData
+	if len(data) != 2 || data["color"] != "blue" || data["level"] != "2" {
+		t.Errorf("got custom data %v", data)
+	}
+
+	err = pf.UpdateUserInventoryItemCustomData(context.Background(), &playfab.UpdateUserInventoryItemDataRequest{
+		PlayFabId:      "player",
+		ItemInstanceId: "nope",
+		Data:           map[string]string{"color": "blue"},
+	})
+	if !errors.Is(err, playfab.ErrItemNotFound) {
+		t.Errorf("got %v, want ErrItemNotFound", err)
+	}
+}
+
+func TestMoveItem(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddInventoryItems("player", playfab.ItemInstance{ItemInstanceId: "sword", ItemId: "sword"})
+	srv.AddCharacter("player", "knight")
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+	req := &playfab.MoveItemRequest{PlayFabId: "player", CharacterId: "knight", ItemInstanceId: "sword"}
+
+	if err := pf.MoveItemToCharacterFromUser(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if inv := srv.CharacterInventory("player", "knight"); len(inv) != 1 || len(srv.Inventory("player")) != 0 {
+		t.Fatalf("character holds %+v, player %+v", inv, srv.Inventory("player"))
+	}
+
+	if err := pf.MoveItemToUserFromCharacter(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if inv := srv.Inventory("player"); len(inv) != 1 || inv[0].ItemInstanceId != "sword" || len(srv.CharacterInventory("player", "knight")) != 0 {
+		t.Errorf("player holds %+v after moving the sword back", inv)
+	}
+
+	err := pf.MoveItemToCharacterFromUser(ctx, &playfab.MoveItemRequest{PlayFabId: "player", CharacterId: "mage", ItemInstanceId: "sword"})
+	if !errors.Is(err, playfab.ErrCharacterNotFound) {
+		t.Errorf("got %v, want ErrCharacterNotFound", err)
+	}
+	if err := pf.MoveItemToUserFromCharacter(ctx, req); !errors.Is(err, playfab.ErrItemNotFound) {
+		t.Errorf("got %v, want ErrItemNotFound", err)
+	}
+}
+
+func TestGrantItemsToUsers(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetCatalog("main", playfab.CatalogItem{ItemId: "sword"})
+	srv.SetCatalog("winter", playfab.CatalogItem{ItemId: "sled"})
+	srv.AddPlayer("alice")
+	srv.AddPlayer("bob")
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	res, err := pf.GrantItemsToUsers(ctx, &playfab.GrantItemsToUsersRequest{ItemGrants: []playfab.ItemGrant{
+		{PlayFabId: "alice", ItemId: "sword", Annotation: "reward"},
+		{PlayFabId: "bob", ItemId: "sword"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.ItemGrantResults) != 2 || !res.ItemGrantResults[0].Result || res.ItemGrantResults[0].PlayFabId != "alice" || res.ItemGrantResults[1].PlayFabId != "bob" {
+		t.Fatalf("got %+v", res)
+	}
+	if inv := srv.Inventory("alice"); len(inv) != 1 || inv[0].Annotation != "reward" {
+		t.Errorf("alice holds %+v", inv)
+	}
+	if inv := srv.Inventory("bob"); len(inv) != 1 || inv[0].ItemId != "sword" {
+		t.Errorf("bob holds %+v", inv)
+	}
+
+	res, err = pf.GrantItemsToUsers(ctx, &playfab.GrantItemsToUsersRequest{
+		CatalogVersion: "winter",
+		ItemGrants:     []playfab.ItemGrant{{PlayFabId: "bob", ItemId: "sled"}},
+	})
+	if err != nil || len(res.ItemGrantResults) != 1 || !res.ItemGrantResults[0].Result {
+		t.Fatalf("got %+v, %v", res, err)
+	}
+	if v, _ := sentCatalogVersion(t, srv, "GrantItemsToUsers"); v != "winter" {
+		t.Errorf("sent CatalogVersion %q, want winter", v)
+	}
+}
+
+func newContainerServer() *playfabtest.Server {
+	srv := playfabtest.NewServer()
+	srv.SetCatalog("main",
+		playfab.CatalogItem{ItemId: "sword"},
+		playfab.CatalogItem{ItemId: "key", IsStackable: true},
+		playfab.CatalogItem{ItemId: "chest", Container: &playfab.CatalogItemContainerInfo{
+			KeyItemId:               "key",
+			ItemContents:            []string{"sword"},
+			VirtualCurrencyContents: map[string]uint32{"GO": 10},
+		}},
+	)
+	srv.AddInventoryItems("player",
+		playfab.ItemInstance{ItemInstanceId: "chest1", ItemId: "chest", CatalogVersion: "main"},
+		playfab.ItemInstance{ItemInstanceId: "chest2", ItemId: "chest", CatalogVersion: "main"},
+		playfab.ItemInstance{ItemInstanceId: "keys", ItemId: "key", CatalogVersion: "main", RemainingUses: int32p(1)},
+	)
+	return srv
+}
+
+func TestUnlockContainerInstance(t *testing.T) {
+	srv := newContainerServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	res, err := pf.UnlockContainerInstance(ctx, &playfab.UnlockContainerInstanceRequest{PlayFabId: "player", ContainerItemInstanceId: "chest2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.UnlockedItemInstanceId != "chest2" || res.UnlockedWithItemInstanceId != "keys" {
+		t.Errorf("got %+v", res)
+	}
+	if len(res.GrantedItems) != 1 || res.GrantedItems[0].ItemId != "sword" || res.VirtualCurrency["GO"] != 10 {
+		t.Errorf("got contents %+v, %v", res.GrantedItems, res.VirtualCurrency)
+	}
+	if balance := srv.VirtualCurrency("player")["GO"]; balance != 10 {
+		t.Errorf("balance is %d, want 10", balance)
+	}
+
+	// The only key was used up.
+	_, err = pf.UnlockContainerInstance(ctx, &playfab.UnlockContainerInstanceRequest{PlayFabId: "player", ContainerItemInstanceId: "chest1"})
+	if !errors.Is(err, playfab.ErrKeyNotOwned) {
+		t.Errorf("got %v, want ErrKeyNotOwned", err)
+	}
+	_, err = pf.UnlockContainerInstance(ctx, &playfab.UnlockContainerInstanceRequest{PlayFabId: "player", ContainerItemInstanceId: "chest2"})
+	if !errors.Is(err, playfab.ErrContainerNotOwned) {
+		t.Errorf("got %v, want ErrContainerNotOwned", err)
+	}
+}
+
+func TestUnlockContainerItem(t *testing.T) {
+	srv := newContainerServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	res, err := pf.UnlockContainerItem(ctx, &playfab.UnlockContainerItemRequest{PlayFabId: "player", ContainerItemId: "chest"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.UnlockedItemInstanceId != "chest1" || len(res.GrantedItems) != 1 {
+		t.Errorf("got %+v", res)
+	}
+	if v, _ := sentCatalogVersion(t, srv, "UnlockContainerItem"); v != "main" {
+		t.Errorf("sent CatalogVersion %q, want the client default", v)
+	}
+
+	_, err = pf.UnlockContainerItem(ctx, &playfab.UnlockContainerItemRequest{PlayFabId: "player", ContainerItemId: "crate"})
+	if !errors.Is(err, playfab.ErrContainerNotOwned) {
+		t.Errorf("got %v, want ErrContainerNotOwned", err)
+	}
+}
+
+func TestRedeemCoupon(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetCatalog("main", playfab.CatalogItem{ItemId: "sword"}, playfab.CatalogItem{ItemId: "shield"})
+	srv.AddCoupon("WELCOME", "sword", "shield")
+	srv.AddPlayer("player")
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	res, err := pf.RedeemCoupon(ctx, &playfab.RedeemCouponRequest{PlayFabId: "player", CouponCode: "WELCOME"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.GrantedItems) != 2 || res.GrantedItems[0].ItemId != "sword" || res.GrantedItems[1].ItemId != "shield" {
+		t.Errorf("got %+v", res)
+	}
+	if n := len(srv.Inventory("player")); n != 2 {
+		t.Errorf("player holds %d items, want 2", n)
+	}
+
+	_, err = pf.RedeemCoupon(ctx, &playfab.RedeemCouponRequest{PlayFabId: "player", CouponCode: "WELCOME"})
+	if !errors.Is(err, playfab.ErrCouponAlreadyRedeemed) {
+		t.Errorf("got %v, want ErrCouponAlreadyRedeemed", err)
+	}
+	_, err = pf.RedeemCoupon(ctx, &playfab.RedeemCouponRequest{PlayFabId: "player", CouponCode: "NOPE"})
+	if !errors.Is(err, playfab.ErrCouponCodeNotFound) {
+		t.Errorf("got %v, want ErrCouponCodeNotFound", err)
+	}
+}
+
+func TestConsumeItemsByItemId(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddInventoryItems("player",
+		playfab.ItemInstance{ItemId: "gem", RemainingUses: int32p(2)},
+		playfab.ItemInstance{ItemId: "gem", RemainingUses: int32p(3)},
+	)
+	pf, _ := srv.NewClient("main")
+
+	res, err := pf.ConsumeItemsByItemId(context.Background(), "player", "gem", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 || res[1].RemainingUses != 1 {
+		t.Errorf("got %+v, want both stacks consumed down to 1 use", res)
+	}
+
+	_, err = pf.ConsumeItemsByItemId(context.Background(), "player", "gem", 2)
+	if !errors.Is(err, playfab.ErrNoRemainingUses) {
+		t.Errorf("got %v, want ErrNoRemainingUses", err)
+	}
+}
+
+func TestConsumeItemsByItemIdInvalidCount(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddInventoryItems("player", playfab.ItemInstance{ItemId: "gem", RemainingUses: int32p(2)})
+	pf, _ := srv.NewClient("main")
+
+	for _, count := range []int32{0, -2} {
+		_, err := pf.ConsumeItemsByItemId(context.Background(), "player", "gem", count)
+		if !errors.Is(err, playfab.ErrInvalidParams) {
+			t.Errorf("consuming %d: got %v, want ErrInvalidParams", count, err)
+		}
+	}
+	if srv.Calls("GetUserInventory") != 0 || srv.Calls("ConsumeItem") != 0 {
+		t.Error("PlayFab was called for an invalid count")
+	}
+	if uses := *srv.Inventory("player")[0].RemainingUses; uses != 2 {
+		t.Errorf("gem has %d uses, want 2", uses)
+	}
+}
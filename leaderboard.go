@@ -0,0 +1,78 @@
+package playfab
+
+import (
+	"context"
+	"time"
+)
+
+type PlayerLeaderboardEntry struct {
+	PlayFabId   string
+	DisplayName string `json:",omitempty"`
+	StatValue   int32
+	Position    int32
+	Profile     *PlayerProfileModel `json:",omitempty"`
+}
+
+// GetLeaderboardRequest reads the current version of a statistic unless
+// UseSpecificVersion is set, in which case Version selects the one to read.
+// The same holds for the other leaderboard requests.
+type GetLeaderboardRequest struct {
+	StatisticName      string
+	StartPosition      int32
+	MaxResultsCount    int32
+	ProfileConstraints *PlayerProfileViewConstraints `json:",omitempty"`
+	UseSpecificVersion bool                          `json:",omitempty"`
+	Version            *uint32                       `json:",omitempty"`
+}
+
+type GetLeaderboardAroundUserRequest struct {
+	PlayFabId          string
+	StatisticName      string
+	MaxResultsCount    int32
+	ProfileConstraints *PlayerProfileViewConstraints `json:",omitempty"`
+	UseSpecificVersion bool                          `json:",omitempty"`
+	Version            *uint32                       `json:",omitempty"`
+}
+
+type GetFriendLeaderboardRequest struct {
+	PlayFabId              string
+	StatisticName          string
+	StartPosition          int32
+	MaxResultsCount        int32
+	IncludeFacebookFriends bool                          `json:",omitempty"`
+	IncludeSteamFriends    bool                          `json:",omitempty"`
+	XboxToken              string                        `json:",omitempty"`
+	ProfileConstraints     *PlayerProfileViewConstraints `json:",omitempty"`
+	UseSpecificVersion     bool                          `json:",omitempty"`
+	Version                *uint32                       `json:",omitempty"`
+}
+
+type GetLeaderboardResult struct {
+	Leaderboard []PlayerLeaderboardEntry
+	Version     int32
+	NextReset   *time.Time
+}
+
+func (pf *PlayFab) GetLeaderboard(ctx context.Context, req *GetLeaderboardRequest) (*GetLeaderboardResult, error) {
+	res := &GetLeaderboardResult{}
+	if err := pf.call(ctx, "Server", "GetLeaderboard", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetLeaderboardAroundUser(ctx context.Context, req *GetLeaderboardAroundUserRequest) (*GetLeaderboardResult, error) {
+	res := &GetLeaderboardResult{}
+	if err := pf.call(ctx, "Server", "GetLeaderboardAroundUser", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetFriendLeaderboard(ctx context.Context, req *GetFriendLeaderboardRequest) (*GetLeaderboardResult, error) {
+	res := &GetLeaderboardResult{}
+	if err := pf.call(ctx, "Server", "GetFriendLeaderboard", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
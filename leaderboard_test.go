@@ -0,0 +1,61 @@
+package playfab_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func uint32p(n uint32) *uint32 { return &n }
+
+func TestGetLeaderboard(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetStatistic("a", "score", 10)
+	srv.SetStatistic("b", "score", 30)
+	srv.SetStatistic("c", "score", 20)
+	pf, _ := srv.NewClient("main")
+
+	res, err := pf.GetLeaderboard(context.Background(), &playfab.GetLeaderboardRequest{StatisticName: "score", MaxResultsCount: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Leaderboard) != 2 || res.Leaderboard[0].PlayFabId != "b" || res.Leaderboard[1].Position != 1 {
+		t.Errorf("got %+v", res.Leaderboard)
+	}
+
+	res, err = pf.GetLeaderboardAroundUser(context.Background(), &playfab.GetLeaderboardAroundUserRequest{
+		PlayFabId:       "a",
+		StatisticName:   "score",
+		MaxResultsCount: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Leaderboard) != 1 || res.Leaderboard[0].PlayFabId != "a" || res.Leaderboard[0].Position != 2 {
+		t.Errorf("got %+v", res.Leaderboard)
+	}
+}
+
+func TestGetLeaderboardSpecificVersionZero(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetStatistic("a", "score", 10)
+	srv.SetStatisticVersion("score", 1)
+	srv.SetStatistic("b", "score", 20)
+	pf, _ := srv.NewClient("main")
+
+	res, err := pf.GetLeaderboard(context.Background(), &playfab.GetLeaderboardRequest{
+		StatisticName:      "score",
+		UseSpecificVersion: true,
+		Version:            uint32p(0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Version != 0 || len(res.Leaderboard) != 1 || res.Leaderboard[0].PlayFabId != "a" {
+		t.Errorf("got version %d and %+v, want version 0 with player a", res.Version, res.Leaderboard)
+	}
+}
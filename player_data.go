@@ -0,0 +1,89 @@
+package playfab
+
+import "context"
+
+// UserDataKind names one of the key/value stores PlayFab keeps per player.
+// The Server API functions for a kind are Get{kind} and Update{kind}.
+type UserDataKind string
+
+const (
+	// UserDataKindPlayer is data the player can read and write from the
+	// client.
+	UserDataKindPlayer            UserDataKind = "UserData"
+	UserDataKindReadOnly          UserDataKind = "UserReadOnlyData"
+	UserDataKindInternal          UserDataKind = "UserInternalData"
+	UserDataKindPublisher         UserDataKind = "UserPublisherData"
+	UserDataKindPublisherReadOnly UserDataKind = "UserPublisherReadOnlyData"
+	UserDataKindPublisherInternal UserDataKind = "UserPublisherInternalData"
+)
+
+// internal reports whether the kind is hidden from the player, in which case
+// its records have no Permission.
+func (k UserDataKind) internal() bool {
+	return k == UserDataKindInternal || k == UserDataKindPublisherInternal
+}
+
+// GetUserDataRecords reads the records of a kind of player data. With
+// IfChangedFromDataVersion set Data is empty unless the data changed after
+// that version.
+func (pf *PlayFab) GetUserDataRecords(ctx context.Context, kind UserDataKind, req *GetUserDataRequest) (*GetUserDataResult, error) {
+	res := &GetUserDataResult{}
+	if err := pf.call(ctx, "Server", "Get"+string(kind), req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateUserDataRecords writes a kind of player data. Permission is not sent
+// for the internal kinds.
+func (pf *PlayFab) UpdateUserDataRecords(ctx context.Context, kind UserDataKind, req *UpdateUserDataRequest) (*UpdateUserDataResult, error) {
+	var r interface{} = req
+	if kind.internal() {
+		r = &UpdateUserInternalDataRequest{
+			PlayFabId:    req.PlayFabId,
+			Data:         req.Data,
+			KeysToRemove: req.KeysToRemove,
+		}
+	}
+	res := &UpdateUserDataResult{}
+	if err := pf.call(ctx, "Server", "Update"+string(kind), r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetUserData(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error) {
+	return pf.GetUserDataRecords(ctx, UserDataKindPlayer, req)
+}
+
+func (pf *PlayFab) UpdateUserData(ctx context.Context, req *UpdateUserDataRequest) (*UpdateUserDataResult, error) {
+	return pf.UpdateUserDataRecords(ctx, UserDataKindPlayer, req)
+}
+
+func (pf *PlayFab) GetUserPublisherData(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error) {
+	return pf.GetUserDataRecords(ctx, UserDataKindPublisher, req)
+}
+
+func (pf *PlayFab) UpdateUserPublisherData(ctx context.Context, req *UpdateUserDataRequest) (*UpdateUserDataResult, error) {
+	return pf.UpdateUserDataRecords(ctx, UserDataKindPublisher, req)
+}
+
+func (pf *PlayFab) GetUserPublisherReadOnlyData(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error) {
+	return pf.GetUserDataRecords(ctx, UserDataKindPublisherReadOnly, req)
+}
+
+func (pf *PlayFab) UpdateUserPublisherReadOnlyData(ctx context.Context, req *UpdateUserDataRequest) (*UpdateUserDataResult, error) {
+	return pf.UpdateUserDataRecords(ctx, UserDataKindPublisherReadOnly, req)
+}
+
+func (pf *PlayFab) GetUserPublisherInternalData(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error) {
+	return pf.GetUserDataRecords(ctx, UserDataKindPublisherInternal, req)
+}
+
+func (pf *PlayFab) UpdateUserPublisherInternalData(ctx context.Context, req *UpdateUserInternalDataRequest) (*UpdateUserDataResult, error) {
+	return pf.UpdateUserDataRecords(ctx, UserDataKindPublisherInternal, &UpdateUserDataRequest{
+		PlayFabId:    req.PlayFabId,
+		Data:         req.Data,
+		KeysToRemove: req.KeysToRemove,
+	})
+}
@@ -0,0 +1,87 @@
+package playfab_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func TestUpdateUserDataRecordsPermission(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddPlayer("player")
+	pf, _ := srv.NewClient("main")
+
+	for _, tc := range []struct {
+		kind       playfab.UserDataKind
+		permission bool
+	}{
+		{playfab.UserDataKindPlayer, true},
+		{playfab.UserDataKindReadOnly, true},
+		{playfab.UserDataKindInternal, false},
+		{playfab.UserDataKindPublisher, true},
+		{playfab.UserDataKindPublisherReadOnly, true},
+		{playfab.UserDataKindPublisherInternal, false},
+	} {
+		_, err := pf.UpdateUserDataRecords(context.Background(), tc.kind, &playfab.UpdateUserDataRequest{
+			PlayFabId:  "player",
+			Data:       map[string]string{"k": "v"},
+			Permission: playfab.UserDataPermissionPublic,
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.kind, err)
+		}
+		reqs := srv.Requests("Update" + string(tc.kind))
+		var sent map[string]interface{}
+		if err := json.Unmarshal(reqs[len(reqs)-1], &sent); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := sent["Permission"]; ok != tc.permission {
+			t.Errorf("%s: sent %v, want Permission sent: %v", tc.kind, sent, tc.permission)
+		}
+		if v := srv.UserDataRecords("player", tc.kind)["k"]; v != "v" {
+			t.Errorf("%s: stored %q, want v", tc.kind, v)
+		}
+	}
+}
+
+func TestPlayerAndPublisherData(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddPlayer("player")
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	_, err := pf.UpdateUserData(ctx, &playfab.UpdateUserDataRequest{
+		PlayFabId:  "player",
+		Data:       map[string]string{"name": "ann"},
+		Permission: playfab.UserDataPermissionPublic,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := pf.GetUserData(ctx, &playfab.GetUserDataRequest{PlayFabId: "player"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := res.Data["name"]; r.Value != "ann" || r.Permission != playfab.UserDataPermissionPublic {
+		t.Errorf("got %+v, want a public record", r)
+	}
+
+	if _, err := pf.UpdateUserPublisherData(ctx, &playfab.UpdateUserDataRequest{PlayFabId: "player", Data: map[string]string{"xp": "9"}}); err != nil {
+		t.Fatal(err)
+	}
+	res, err = pf.GetUserPublisherData(ctx, &playfab.GetUserDataRequest{PlayFabId: "player", Keys: []string{"xp"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Data["xp"].Value != "9" || res.DataVersion != 1 {
+		t.Errorf("got %+v", res)
+	}
+	if data := srv.UserDataRecords("player", playfab.UserDataKindPlayer); len(data) != 1 {
+		t.Errorf("player data is %v, want it apart from the publisher data", data)
+	}
+}
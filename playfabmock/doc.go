@@ -0,0 +1,3 @@
+// Package playfabmock provides mock implementations of the playfab API
+// interfaces. The mocks are generated with moq, see go:generate in api.go.
+package playfabmock
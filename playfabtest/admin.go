@@ -0,0 +1,195 @@
+package playfabtest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+)
+
+var adminHandlers = map[string]handler{
+	"SetTitleData":             setTitleData,
+	"SetTitleInternalData":     setTitleInternalData,
+	"SetTitleDataAndOverrides": setTitleDataAndOverrides,
+
+	"SetCatalogItems":    updateCatalogItems(true),
+	"UpdateCatalogItems": updateCatalogItems(false),
+	"SetStoreItems":      updateStoreItems(true),
+	"UpdateStoreItems":   updateStoreItems(false),
+
+	"AddVirtualCurrencyTypes":  addVirtualCurrencyTypes,
+	"UpdateRandomResultTables": updateRandomResultTables,
+
+	"CreatePlayerStatisticDefinition": createPlayerStatisticDefinition,
+	"IncrementPlayerStatisticVersion": incrementPlayerStatisticVersion,
+}
+
+func setTitleDataAndOverrides(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.SetTitleDataAndOverridesRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	data := s.titleData
+	if req.OverrideLabel != "" {
+		data = s.titleDataOverride(req.OverrideLabel)
+	}
+	for _, kv := range req.KeyValues {
+		setKey(data, kv.Key, kv.Value)
+	}
+	return nil, nil
+}
+
+// updateCatalogItems replaces the catalog version when replace is set and
+// otherwise adds the items, replacing those with the same ItemId.
+func updateCatalogItems(replace bool) handler {
+	return func(s *Server, body []byte) (interface{}, *Failure) {
+		var req playfab.UpdateCatalogItemsRequest
+		if f := decode(body, &req); f != nil {
+			return nil, f
+		}
+		catalogVersion := s.catalogVersion(req.CatalogVersion)
+		if catalogVersion == "" {
+			return nil, invalidParams("CatalogVersion is required without a primary catalog")
+		}
+		var catalog []playfab.CatalogItem
+		if !replace {
+			catalog = s.catalogs[catalogVersion]
+		}
+		for _, item := range req.Catalog {
+			item.CatalogVersion = catalogVersion
+			catalog = upsertCatalogItem(catalog, item)
+		}
+		s.catalogs[catalogVersion] = catalog
+		if s.primaryCatalog == "" || (req.SetAsDefaultCatalog != nil && *req.SetAsDefaultCatalog) {
+			s.primaryCatalog = catalogVersion
+		}
+		return nil, nil
+	}
+}
+
+func upsertCatalogItem(catalog []playfab.CatalogItem, item playfab.CatalogItem) []playfab.CatalogItem {
+	for i := range catalog {
+		if catalog[i].ItemId == item.ItemId {
+			catalog[i] = item
+			return catalog
+		}
+	}
+	return append(catalog, item)
+}
+
+// updateStoreItems creates or replaces the store when replace is set and
+// otherwise adds the items to an existing store.
+func updateStoreItems(replace bool) handler {
+	return func(s *Server, body []byte) (interface{}, *Failure) {
+		var req playfab.UpdateStoreItemsRequest
+		if f := decode(body, &req); f != nil {
+			return nil, f
+		}
+		catalogVersion := s.catalogVersion(req.CatalogVersion)
+		store, ok := s.stores[catalogVersion][req.StoreId]
+		if !replace && !ok {
+			return nil, &Failure{ErrorCode: playfab.ErrStoreNotFound, Message: "Store not found"}
+		}
+		if replace || !ok {
+			store = &Store{}
+			if s.stores[catalogVersion] == nil {
+				s.stores[catalogVersion] = make(map[string]*Store)
+			}
+			s.stores[catalogVersion][req.StoreId] = store
+		}
+		for _, item := range req.Store {
+			store.Items = upsertStoreItem(store.Items, item)
+		}
+		if req.MarketingData != nil {
+			store.MarketingData = req.MarketingData
+		}
+		return nil, nil
+	}
+}
+
+func upsertStoreItem(items []playfab.StoreItem, item playfab.StoreItem) []playfab.StoreItem {
+	for i := range items {
+		if items[i].ItemId == item.ItemId {
+			items[i] = item
+			return items
+		}
+	}
+	return append(items, item)
+}
+
+func addVirtualCurrencyTypes(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.AddVirtualCurrencyTypesRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	for _, vc := range req.VirtualCurrencies {
+		if len(vc.CurrencyCode) != 2 {
+			return nil, invalidParams(fmt.Sprintf("currency code %q must be two characters", vc.CurrencyCode))
+		}
+	}
+	for _, vc := range req.VirtualCurrencies {
+		s.currencyTypes[vc.CurrencyCode] = vc
+	}
+	return nil, nil
+}
+
+// updateRandomResultTables stores the item nodes of each table. The fake picks
+// results with equal weight and does not support nested tables.
+func updateRandomResultTables(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.UpdateRandomResultTablesRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	tables := make(map[string][]string, len(req.Tables))
+	for _, t := range req.Tables {
+		var itemIds []string
+		for _, n := range t.Nodes {
+			if n.ResultItemType != playfab.ResultTableNodeItemId {
+				return nil, invalidParams(fmt.Sprintf("table %s: playfabtest does not support %s nodes", t.TableId, n.ResultItemType))
+			}
+			itemIds = append(itemIds, n.ResultItem)
+		}
+		tables[t.TableId] = itemIds
+	}
+	for tableId, itemIds := range tables {
+		s.randomTables[tableId] = itemIds
+	}
+	return nil, nil
+}
+
+func createPlayerStatisticDefinition(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.CreatePlayerStatisticDefinitionRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	if _, ok := s.statDefinitions[req.StatisticName]; ok {
+		return nil, invalidParams(fmt.Sprintf("statistic %s already exists", req.StatisticName))
+	}
+	def := playfab.PlayerStatisticDefinition{
+		StatisticName:         req.StatisticName,
+		VersionChangeInterval: req.VersionChangeInterval,
+		AggregationMethod:     req.AggregationMethod,
+	}
+	s.statDefinitions[req.StatisticName] = def
+	def.CurrentVersion = s.statVersions[req.StatisticName]
+	return &playfab.CreatePlayerStatisticDefinitionResult{Statistic: &def}, nil
+}
+
+func incrementPlayerStatisticVersion(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.IncrementPlayerStatisticVersionRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	if _, ok := s.statDefinitions[req.StatisticName]; !ok {
+		return nil, &Failure{ErrorCode: playfab.ErrStatisticNotFound, Message: "Statistic not found"}
+	}
+	s.statVersions[req.StatisticName]++
+	now := time.Now().UTC()
+	return &playfab.IncrementPlayerStatisticVersionResult{
+		StatisticVersion: &playfab.PlayerStatisticVersion{
+			StatisticName:  req.StatisticName,
+			Version:        s.statVersions[req.StatisticName],
+			ActivationTime: &now,
+		},
+	}, nil
+}
@@ -0,0 +1,346 @@
+package playfabtest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+)
+
+// DefaultEntityTokenLifetime is how long entity tokens issued by the fake
+// are valid unless Server.EntityTokenLifetime says otherwise.
+const DefaultEntityTokenLifetime = 24 * time.Hour
+
+// clientHandler handles a Client API call of a logged in player.
+type clientHandler func(s *Server, p *player, body []byte) (interface{}, *Failure)
+
+// entityHandler handles an entity API call made with an entity token.
+type entityHandler func(s *Server, entity playfab.EntityKey, body []byte) (interface{}, *Failure)
+
+// loginHandlers are the Client API functions called without a session.
+var loginHandlers = map[string]handler{
+	"LoginWithCustomID":        loginWith("CustomId"),
+	"LoginWithAndroidDeviceID": loginWith("AndroidDeviceId"),
+	"LoginWithIOSDeviceID":     loginWith("DeviceId"),
+	"LoginWithEmailAddress":    loginWithPassword("Email"),
+	"LoginWithPlayFab":         loginWithPassword("Username"),
+	"RegisterPlayFabUser":      registerPlayFabUser,
+}
+
+var clientHandlers = map[string]clientHandler{
+	"GetUserInventory": clientGetUserInventory,
+	"PurchaseItem":     purchaseItem,
+}
+
+var entityHandlers = map[string]map[string]entityHandler{
+	"Profile": {
+		"GetProfile": getProfile,
+	},
+}
+
+type entityToken struct {
+	entity  playfab.EntityKey
+	expires time.Time
+}
+
+// loginRequest holds the fields of the login requests as they are sent. The
+// account is identified by the field named by the handler.
+type loginRequest struct {
+	TitleId         string
+	CustomId        string
+	AndroidDeviceId string
+	DeviceId        string
+	Email           string
+	Username        string
+	Password        string
+	CreateAccount   bool
+}
+
+func (r *loginRequest) id(field string) string {
+	switch field {
+	case "CustomId":
+		return r.CustomId
+	case "AndroidDeviceId":
+		return r.AndroidDeviceId
+	case "DeviceId":
+		return r.DeviceId
+	case "Email":
+		return r.Email
+	case "Username":
+		return r.Username
+	}
+	return ""
+}
+
+// loginWith logs in with an id that needs no password, creating the account
+// when CreateAccount is set.
+func loginWith(field string) handler {
+	return func(s *Server, body []byte) (interface{}, *Failure) {
+		var req loginRequest
+		if f := s.decodeLogin(body, &req); f != nil {
+			return nil, f
+		}
+		id := req.id(field)
+		if id == "" {
+			return nil, invalidParams(field + " is required")
+		}
+		playFabId, ok := s.accounts[field+":"+id]
+		if !ok && !req.CreateAccount {
+			return nil, &Failure{ErrorCode: playfab.ErrAccountNotFound, Message: "User not found"}
+		}
+		if !ok {
+			playFabId = s.newPlayFabId()
+			s.accounts[field+":"+id] = playFabId
+		}
+		return s.login(s.ensurePlayer(playFabId), !ok), nil
+	}
+}
+
+// loginWithPassword logs in to an account made with RegisterPlayFabUser.
+func loginWithPassword(field string) handler {
+	return func(s *Server, body []byte) (interface{}, *Failure) {
+		var req loginRequest
+		if f := s.decodeLogin(body, &req); f != nil {
+			return nil, f
+		}
+		playFabId, ok := s.accounts[field+":"+req.id(field)]
+		if !ok {
+			return nil, &Failure{ErrorCode: playfab.ErrAccountNotFound, Message: "User not found"}
+		}
+		if s.passwords[playFabId] != req.Password {
+			return nil, &Failure{ErrorCode: playfab.ErrInvalidUsernameOrPassword, Message: "Invalid username or password"}
+		}
+		return s.login(s.ensurePlayer(playFabId), false), nil
+	}
+}
+
+func registerPlayFabUser(s *Server, body []byte) (interface{}, *Failure) {
+	var req loginRequest
+	if f := s.decodeLogin(body, &req); f != nil {
+		return nil, f
+	}
+	if req.Email == "" && req.Username == "" {
+		return nil, invalidParams("Email or Username is required")
+	}
+	if len(req.Password) < 6 {
+		return nil, &Failure{ErrorCode: playfab.ErrInvalidPassword, Message: "Invalid password"}
+	}
+	if _, ok := s.accounts["Email:"+req.Email]; ok && req.Email != "" {
+		return nil, &Failure{ErrorCode: playfab.ErrEmailAddressNotAvailable, Message: "Email address not available"}
+	}
+	if _, ok := s.accounts["Username:"+req.Username]; ok && req.Username != "" {
+		return nil, &Failure{ErrorCode: playfab.ErrUsernameNotAvailable, Message: "Username not available"}
+	}
+	playFabId := s.newPlayFabId()
+	if req.Email != "" {
+		s.accounts["Email:"+req.Email] = playFabId
+	}
+	if req.Username != "" {
+		s.accounts["Username:"+req.Username] = playFabId
+	}
+	s.passwords[playFabId] = req.Password
+	login := s.login(s.ensurePlayer(playFabId), true)
+	return &playfab.RegisterPlayFabUserResult{
+		PlayFabId:     login.PlayFabId,
+		SessionTicket: login.SessionTicket,
+		Username:      req.Username,
+		EntityToken:   login.EntityToken,
+	}, nil
+}
+
+func (s *Server) decodeLogin(body []byte, req *loginRequest) *Failure {
+	if f := decode(body, req); f != nil {
+		return f
+	}
+	if req.TitleId != s.TitleId {
+		return &Failure{ErrorCode: playfab.ErrInvalidTitleId, Message: "Invalid title id"}
+	}
+	return nil
+}
+
+// login issues a new session ticket and player entity token for p.
+func (s *Server) login(p *player, created bool) *playfab.LoginResult {
+	s.nextTicket++
+	ticket := fmt.Sprintf("%s-%s-%d", p.id, s.TitleId, s.nextTicket)
+	s.sessions[ticket] = p.id
+	return &playfab.LoginResult{
+		PlayFabId:     p.id,
+		SessionTicket: ticket,
+		NewlyCreated:  created,
+		EntityToken:   s.issueEntityToken(playfab.EntityKey{Id: p.id, Type: "title_player_account"}),
+	}
+}
+
+func (s *Server) issueEntityToken(entity playfab.EntityKey) *playfab.EntityTokenResponse {
+	s.nextTicket++
+	token := fmt.Sprintf("%s-%s-%d", entity.Type, entity.Id, s.nextTicket)
+	lifetime := s.EntityTokenLifetime
+	if lifetime <= 0 {
+		lifetime = DefaultEntityTokenLifetime
+	}
+	expires := time.Now().Add(lifetime).UTC()
+	s.entityTokens[token] = entityToken{entity: entity, expires: expires}
+	return &playfab.EntityTokenResponse{
+		Entity:          &entity,
+		EntityToken:     token,
+		TokenExpiration: &expires,
+	}
+}
+
+func (s *Server) newPlayFabId() string {
+	s.nextPlayFabId++
+	return fmt.Sprintf("%016X", 0xF000000000000000|uint64(s.nextPlayFabId))
+}
+
+// sessionPlayer returns the player a session ticket was issued to.
+func (s *Server) sessionPlayer(ticket string) (*player, *Failure) {
+	if ticket == "" {
+		return nil, &Failure{
+			HTTPStatus: http.StatusUnauthorized,
+			ErrorCode:  playfab.ErrNotAuthenticated,
+			Message:    "This API method does not allow anonymous callers.",
+		}
+	}
+	playFabId, ok := s.sessions[ticket]
+	if !ok {
+		return nil, &Failure{
+			HTTPStatus: http.StatusUnauthorized,
+			ErrorCode:  playfab.ErrInvalidSessionTicket,
+			Message:    "Invalid session ticket",
+		}
+	}
+	return s.player(playFabId)
+}
+
+// entity returns the entity an entity token was issued to.
+func (s *Server) entity(token string) (playfab.EntityKey, *Failure) {
+	if token == "" {
+		return playfab.EntityKey{}, &Failure{
+			HTTPStatus: http.StatusUnauthorized,
+			ErrorCode:  playfab.ErrEntityTokenMissing,
+			Message:    "Entity token missing",
+		}
+	}
+	tok, ok := s.entityTokens[token]
+	if !ok {
+		return playfab.EntityKey{}, &Failure{
+			HTTPStatus: http.StatusUnauthorized,
+			ErrorCode:  playfab.ErrEntityTokenInvalid,
+			Message:    "Entity token invalid",
+		}
+	}
+	if time.Now().After(tok.expires) {
+		return playfab.EntityKey{}, &Failure{
+			HTTPStatus: http.StatusUnauthorized,
+			ErrorCode:  playfab.ErrEntityTokenExpired,
+			Message:    "Entity token expired",
+		}
+	}
+	return tok.entity, nil
+}
+
+// getEntityToken issues a token for the title when called with the title
+// secret, and for the caller when called with a session ticket or entity
+// token.
+func (s *Server) getEntityToken(r *http.Request, body []byte) (interface{}, *Failure) {
+	var req playfab.GetEntityTokenRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	switch {
+	case r.Header.Get("X-SecretKey") != "":
+		if r.Header.Get("X-SecretKey") != s.SecretKey {
+			return nil, invalidSecretKey()
+		}
+		entity := playfab.EntityKey{Id: s.TitleId, Type: "title"}
+		if req.Entity != nil {
+			entity = *req.Entity
+		}
+		return s.issueEntityToken(entity), nil
+	case r.Header.Get("X-Authorization") != "":
+		p, f := s.sessionPlayer(r.Header.Get("X-Authorization"))
+		if f != nil {
+			return nil, f
+		}
+		return s.issueEntityToken(playfab.EntityKey{Id: p.id, Type: "title_player_account"}), nil
+	default:
+		entity, f := s.entity(r.Header.Get("X-EntityToken"))
+		if f != nil {
+			return nil, f
+		}
+		return s.issueEntityToken(entity), nil
+	}
+}
+
+func clientGetUserInventory(s *Server, p *player, body []byte) (interface{}, *Failure) {
+	return &playfab.GetUserInventoryResult{
+		PlayFabId:                    p.id,
+		Inventory:                    append([]playfab.ItemInstance{}, p.inventory...),
+		VirtualCurrency:              copyCurrency(p.currency),
+		VirtualCurrencyRechargeTimes: map[string]playfab.VirtualCurrencyRechargeTime{},
+	}, nil
+}
+
+// purchaseItem checks the price against the store, or the catalog without a
+// StoreId, and debits and grants in one step as PlayFab does.
+func purchaseItem(s *Server, p *player, body []byte) (interface{}, *Failure) {
+	var req playfab.PurchaseItemRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	catalogVersion := s.catalogVersion(req.CatalogVersion)
+	item, ok := s.catalogItem(catalogVersion, req.ItemId)
+	if !ok {
+		return nil, &Failure{ErrorCode: playfab.ErrItemNotFound, Message: "Item not found"}
+	}
+	prices := item.VirtualCurrencyPrices
+	if req.StoreId != "" {
+		store, ok := s.stores[catalogVersion][req.StoreId]
+		if !ok {
+			return nil, &Failure{ErrorCode: playfab.ErrStoreNotFound, Message: "Store not found"}
+		}
+		prices = nil
+		for _, si := range store.Items {
+			if si.ItemId == req.ItemId {
+				prices = si.VirtualCurrencyPrices
+			}
+		}
+		if prices == nil {
+			return nil, &Failure{ErrorCode: playfab.ErrItemNotFound, Message: "Item not found in store"}
+		}
+	}
+	price, ok := prices[req.VirtualCurrency]
+	if !ok {
+		return nil, &Failure{ErrorCode: playfab.ErrWrongVirtualCurrency, Message: "Wrong virtual currency"}
+	}
+	if int64(price) != int64(req.Price) {
+		return nil, &Failure{ErrorCode: playfab.ErrWrongPrice, Message: "Wrong price"}
+	}
+	if int64(p.currency[req.VirtualCurrency]) < int64(price) {
+		f := InsufficientFunds
+		return nil, &f
+	}
+	p.currency[req.VirtualCurrency] -= req.Price
+	res := &playfab.PurchaseItemResult{Items: []playfab.ItemInstance{}}
+	for _, g := range s.grant(p, catalogVersion, []string{req.ItemId}, "", "") {
+		res.Items = append(res.Items, g.ItemInstance)
+	}
+	return res, nil
+}
+
+// getProfile returns the entity of the token, or the entity asked for.
+func getProfile(s *Server, entity playfab.EntityKey, body []byte) (interface{}, *Failure) {
+	var req struct {
+		Entity *playfab.EntityKey
+	}
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	if req.Entity != nil {
+		entity = *req.Entity
+	}
+	return map[string]interface{}{
+		"Profile": map[string]interface{}{"Entity": entity},
+	}, nil
+}
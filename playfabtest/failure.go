@@ -0,0 +1,66 @@
+package playfabtest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+)
+
+// Failure is an error response the fake server sends instead of handling a call.
+type Failure struct {
+	// HTTPStatus defaults to 400 Bad Request.
+	HTTPStatus int
+	ErrorCode  playfab.ErrorCode
+	Message    string
+	Details    map[string][]string
+	// RetryAfter is sent as the Retry-After header when set.
+	RetryAfter time.Duration
+	// Raw replaces the JSON error body, as a gateway in front of PlayFab would.
+	Raw string
+}
+
+var (
+	Conflict = Failure{
+		HTTPStatus: http.StatusConflict,
+		ErrorCode:  playfab.ErrConcurrentEditError,
+		Message:    "Conflict",
+	}
+	ServiceUnavailable = Failure{
+		HTTPStatus: http.StatusServiceUnavailable,
+		Raw:        "Service Unavailable",
+	}
+	InsufficientFunds = Failure{
+		ErrorCode: playfab.ErrInsufficientFunds,
+		Message:   "Insufficient funds",
+	}
+	TooManyRequests = Failure{
+		HTTPStatus: http.StatusTooManyRequests,
+		ErrorCode:  playfab.ErrAPIRequestLimitExceeded,
+		Message:    "The number of API requests exceeded the limit",
+		RetryAfter: time.Second,
+	}
+)
+
+// FailNext queues failures for funcName, such as "GrantItemsToUser". Each
+// following call to the function consumes one failure until the queue is
+// empty, after which calls are handled normally again.
+func (s *Server) FailNext(funcName string, failures ...Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[funcName] = append(s.failures[funcName], failures...)
+}
+
+func notFound(path string) *Failure {
+	return &Failure{
+		HTTPStatus: http.StatusNotFound,
+		Message:    "playfabtest does not implement " + path,
+	}
+}
+
+func invalidParams(msg string) *Failure {
+	return &Failure{
+		ErrorCode: playfab.ErrInvalidParams,
+		Message:   "Invalid input parameters: " + msg,
+	}
+}
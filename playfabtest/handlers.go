@@ -0,0 +1,1026 @@
+package playfabtest
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+)
+
+type handler func(s *Server, body []byte) (interface{}, *Failure)
+
+var serverHandlers = map[string]handler{
+	"EvaluateRandomResultTable": evaluateRandomResultTable,
+
+	"GetUserData":                     getUserData("UserData"),
+	"GetUserInternalData":             getUserData("UserInternalData"),
+	"GetUserReadOnlyData":             getUserData("UserReadOnlyData"),
+	"GetUserPublisherData":            getUserData("UserPublisherData"),
+	"GetUserPublisherInternalData":    getUserData("UserPublisherInternalData"),
+	"GetUserPublisherReadOnlyData":    getUserData("UserPublisherReadOnlyData"),
+	"UpdateUserData":                  updateUserData("UserData"),
+	"UpdateUserInternalData":          updateUserData("UserInternalData"),
+	"UpdateUserReadOnlyData":          updateUserData("UserReadOnlyData"),
+	"UpdateUserPublisherData":         updateUserData("UserPublisherData"),
+	"UpdateUserPublisherInternalData": updateUserData("UserPublisherInternalData"),
+	"UpdateUserPublisherReadOnlyData": updateUserData("UserPublisherReadOnlyData"),
+
+	"GetUserInventory":     getUserInventory,
+	"GrantItemsToUser":     grantItemsToUser,
+	"ConsumeItem":          consumeItem,
+	"RevokeInventoryItems": revokeInventoryItems,
+	"GrantItemsToUsers":    grantItemsToUsers,
+	"ModifyItemUses":       modifyItemUses,
+
+	"UpdateUserInventoryItemCustomData": updateUserInventoryItemCustomData,
+	"MoveItemToCharacterFromUser":       moveItemToCharacterFromUser,
+	"MoveItemToUserFromCharacter":       moveItemToUserFromCharacter,
+	"UnlockContainerInstance":           unlockContainerInstance,
+	"UnlockContainerItem":               unlockContainerItem,
+	"RedeemCoupon":                      redeemCoupon,
+
+	"AddUserVirtualCurrency":      addUserVirtualCurrency,
+	"SubtractUserVirtualCurrency": subtractUserVirtualCurrency,
+
+	"GetPlayerStatistics":        getPlayerStatistics,
+	"GetPlayerStatisticVersions": getPlayerStatisticVersions,
+	"UpdatePlayerStatistics":     updatePlayerStatistics,
+	"GetLeaderboard":             getLeaderboard,
+	"GetLeaderboardAroundUser":   getLeaderboardAroundUser,
+	"GetFriendLeaderboard":       getFriendLeaderboard,
+
+	"GetPlayerCombinedInfo": getPlayerCombinedInfo,
+
+	"GetTitleData":         getTitleData,
+	"GetTitleInternalData": getTitleInternalData,
+	"SetTitleData":         setTitleData,
+	"SetTitleInternalData": setTitleInternalData,
+	"GetPublisherData":     getPublisherData,
+	"SetPublisherData":     setPublisherData,
+	"GetCatalogItems":      getCatalogItems,
+	"GetStoreItems":        getStoreItems,
+
+	"SendPushNotification": sendPushNotification,
+	"AddPlayerTag":         addPlayerTag,
+	"RemovePlayerTag":      removePlayerTag,
+	"GetPlayerTags":        getPlayerTags,
+}
+
+func evaluateRandomResultTable(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.EvaluateRandomResultTableRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	items := s.randomTables[req.TableId]
+	if len(items) == 0 {
+		return nil, invalidParams(fmt.Sprintf("random result table %s not found", req.TableId))
+	}
+	return &playfab.EvaluateRandomResultTableResult{
+		ResultItemId: items[rand.Intn(len(items))],
+	}, nil
+}
+
+func getUserData(kind string) handler {
+	return func(s *Server, body []byte) (interface{}, *Failure) {
+		var req playfab.GetUserDataRequest
+		if f := decode(body, &req); f != nil {
+			return nil, f
+		}
+		p, f := s.player(req.PlayFabId)
+		if f != nil {
+			return nil, f
+		}
+		d := p.userData(kind)
+		res := &playfab.GetUserDataResult{
+			PlayFabId:   req.PlayFabId,
+			DataVersion: d.version,
+			Data:        make(map[string]playfab.UserDataRecord),
+		}
+		// Data is only returned when it changed after the caller's version.
+		if req.IfChangedFromDataVersion != nil && d.version <= *req.IfChangedFromDataVersion {
+			return res, nil
+		}
+		for k, r := range d.records {
+			if len(req.Keys) == 0 || contains(req.Keys, k) {
+				res.Data[k] = r
+			}
+		}
+		return res, nil
+	}
+}
+
+func updateUserData(kind string) handler {
+	return func(s *Server, body []byte) (interface{}, *Failure) {
+		var req playfab.UpdateUserDataRequest
+		if f := decode(body, &req); f != nil {
+			return nil, f
+		}
+		p, f := s.player(req.PlayFabId)
+		if f != nil {
+			return nil, f
+		}
+		permission := req.Permission
+		if permission == "" {
+			permission = playfab.UserDataPermissionPrivate
+		}
+		d := p.userData(kind)
+		now := time.Now().UTC()
+		for k, v := range req.Data {
+			d.records[k] = playfab.UserDataRecord{Value: v, LastUpdated: now, Permission: permission}
+		}
+		for _, k := range req.KeysToRemove {
+			delete(d.records, k)
+		}
+		d.version++
+		return &playfab.UpdateUserDataResult{DataVersion: d.version}, nil
+	}
+}
+
+func getUserInventory(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GetUserInventoryRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	return &playfab.GetUserInventoryResult{
+		PlayFabId:                    p.id,
+		Inventory:                    append([]playfab.ItemInstance{}, p.inventory...),
+		VirtualCurrency:              copyCurrency(p.currency),
+		VirtualCurrencyRechargeTimes: map[string]playfab.VirtualCurrencyRechargeTime{},
+	}, nil
+}
+
+func grantItemsToUser(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GrantItemsToUserRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	return &playfab.GrantItemsToUserResult{
+		ItemGrantResults: s.grant(p, s.catalogVersion(req.CatalogVersion), req.ItemIds, req.Annotation, ""),
+	}, nil
+}
+
+func grantItemsToUsers(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GrantItemsToUsersRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	res := &playfab.GrantItemsToUsersResult{ItemGrantResults: []playfab.GrantedItemInstance{}}
+	for _, g := range req.ItemGrants {
+		p, f := s.player(g.PlayFabId)
+		if f != nil {
+			return nil, f
+		}
+		res.ItemGrantResults = append(res.ItemGrantResults, s.grant(p, s.catalogVersion(req.CatalogVersion), []string{g.ItemId}, g.Annotation, "")...)
+	}
+	return res, nil
+}
+
+// grant adds catalog items to the player's inventory the way PlayFab does:
+// stackable items increase the uses of an existing instance and bundles
+// grant their contents.
+func (s *Server) grant(p *player, catalogVersion string, itemIds []string, annotation string, bundleParent string) []playfab.GrantedItemInstance {
+	results := make([]playfab.GrantedItemInstance, 0, len(itemIds))
+	for _, itemId := range itemIds {
+		item, ok := s.catalogItem(catalogVersion, itemId)
+		if !ok {
+			results = append(results, playfab.GrantedItemInstance{
+				ItemInstance: playfab.ItemInstance{ItemId: itemId},
+				PlayFabId:    p.id,
+			})
+			continue
+		}
+
+		var uses *int32
+		if item.Consumable != nil && item.Consumable.UsageCount != nil {
+			n := int32(*item.Consumable.UsageCount)
+			uses = &n
+		} else if item.IsStackable {
+			n := int32(1)
+			uses = &n
+		}
+
+		if item.IsStackable {
+			if i := p.findStack(catalogVersion, itemId); i >= 0 {
+				existing := &p.inventory[i]
+				total := *existing.RemainingUses + *uses
+				existing.RemainingUses = &total
+				granted := *existing
+				granted.UsesIncrementedBy = uses
+				results = append(results, playfab.GrantedItemInstance{ItemInstance: granted, PlayFabId: p.id, Result: true})
+				continue
+			}
+		}
+
+		now := time.Now().UTC()
+		instance := playfab.ItemInstance{
+			ItemId:            item.ItemId,
+			ItemInstanceId:    s.newInstanceId(),
+			ItemClass:         item.ItemClass,
+			PurchaseDate:      &now,
+			RemainingUses:     uses,
+			UsesIncrementedBy: uses,
+			Annotation:        annotation,
+			CatalogVersion:    catalogVersion,
+			BundleParent:      bundleParent,
+			DisplayName:       item.DisplayName,
+		}
+		if item.Bundle != nil {
+			instance.BundleContents = append([]string(nil), item.Bundle.BundledItems...)
+		}
+		p.inventory = append(p.inventory, instance)
+		results = append(results, playfab.GrantedItemInstance{ItemInstance: instance, PlayFabId: p.id, Result: true})
+
+		if item.Bundle != nil {
+			results = append(results, s.grant(p, catalogVersion, item.Bundle.BundledItems, annotation, instance.ItemInstanceId)...)
+			for currency, amount := range item.Bundle.BundledVirtualCurrencies {
+				p.currency[currency] += int32(amount)
+			}
+		}
+	}
+	return results
+}
+
+func (s *Server) catalogItem(catalogVersion string, itemId string) (playfab.CatalogItem, bool) {
+	for _, item := range s.catalogs[catalogVersion] {
+		if item.ItemId == itemId {
+			return item, true
+		}
+	}
+	return playfab.CatalogItem{}, false
+}
+
+func (p *player) findStack(catalogVersion string, itemId string) int {
+	for i, item := range p.inventory {
+		if item.ItemId == itemId && item.CatalogVersion == catalogVersion && item.RemainingUses != nil {
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *player) findInstance(itemInstanceId string) int {
+	for i, item := range p.inventory {
+		if item.ItemInstanceId == itemInstanceId {
+			return i
+		}
+	}
+	return -1
+}
+
+func consumeItem(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.ConsumeItemRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	if req.ConsumeCount <= 0 {
+		return nil, invalidParams("ConsumeCount must be positive")
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	i := p.findInstance(req.ItemInstanceId)
+	if i < 0 {
+		return nil, &Failure{ErrorCode: playfab.ErrItemNotFound, Message: "Item not found"}
+	}
+	item := &p.inventory[i]
+	if item.RemainingUses == nil || *item.RemainingUses < req.ConsumeCount {
+		return nil, &Failure{ErrorCode: playfab.ErrNoRemainingUses, Message: "No remaining uses"}
+	}
+	remaining := *item.RemainingUses - req.ConsumeCount
+	item.RemainingUses = &remaining
+	if remaining == 0 {
+		p.inventory = append(p.inventory[:i], p.inventory[i+1:]...)
+	}
+	return &playfab.ConsumeItemResult{ItemInstanceId: req.ItemInstanceId, RemainingUses: remaining}, nil
+}
+
+func modifyItemUses(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.ModifyItemUsesRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	if req.UsesToAdd == 0 {
+		return nil, invalidParams("UsesToAdd must not be zero")
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	i := p.findInstance(req.ItemInstanceId)
+	if i < 0 {
+		return nil, &Failure{ErrorCode: playfab.ErrItemNotFound, Message: "Item not found"}
+	}
+	item := &p.inventory[i]
+	if item.RemainingUses == nil {
+		return nil, invalidParams("Item has no uses")
+	}
+	remaining := *item.RemainingUses + req.UsesToAdd
+	if remaining < 0 {
+		return nil, &Failure{ErrorCode: playfab.ErrNoRemainingUses, Message: "No remaining uses"}
+	}
+	item.RemainingUses = &remaining
+	if remaining == 0 {
+		p.inventory = append(p.inventory[:i], p.inventory[i+1:]...)
+	}
+	return &playfab.ModifyItemUsesResult{ItemInstanceId: req.ItemInstanceId, RemainingUses: remaining}, nil
+}
+
+func updateUserInventoryItemCustomData(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.UpdateUserInventoryItemDataRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	i := p.findInstance(req.ItemInstanceId)
+	if i < 0 {
+		return nil, &Failure{ErrorCode: playfab.ErrItemNotFound, Message: "Item not found"}
+	}
+	item := &p.inventory[i]
+	data := copyStrings(item.CustomData, nil)
+	for k, v := range req.Data {
+		data[k] = v
+	}
+	for _, k := range req.KeysToRemove {
+		delete(data, k)
+	}
+	item.CustomData = data
+	return nil, nil
+}
+
+func moveItemToCharacterFromUser(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.MoveItemRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	if _, ok := p.characters[req.CharacterId]; !ok {
+		return nil, &Failure{ErrorCode: playfab.ErrCharacterNotFound, Message: "Character not found"}
+	}
+	i := p.findInstance(req.ItemInstanceId)
+	if i < 0 {
+		return nil, &Failure{ErrorCode: playfab.ErrItemNotFound, Message: "Item not found"}
+	}
+	p.characters[req.CharacterId] = append(p.characters[req.CharacterId], p.inventory[i])
+	p.inventory = append(p.inventory[:i], p.inventory[i+1:]...)
+	return nil, nil
+}
+
+func moveItemToUserFromCharacter(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.MoveItemRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	inventory, ok := p.characters[req.CharacterId]
+	if !ok {
+		return nil, &Failure{ErrorCode: playfab.ErrCharacterNotFound, Message: "Character not found"}
+	}
+	for i, item := range inventory {
+		if item.ItemInstanceId == req.ItemInstanceId {
+			p.inventory = append(p.inventory, item)
+			p.characters[req.CharacterId] = append(inventory[:i], inventory[i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, &Failure{ErrorCode: playfab.ErrItemNotFound, Message: "Item not found"}
+}
+
+func unlockContainerInstance(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.UnlockContainerInstanceRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	i := p.findInstance(req.ContainerItemInstanceId)
+	if i < 0 {
+		return nil, &Failure{ErrorCode: playfab.ErrContainerNotOwned, Message: "Container not owned"}
+	}
+	return s.unlock(p, i, req.KeyItemInstanceId)
+}
+
+func unlockContainerItem(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.UnlockContainerItemRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	for i, item := range p.inventory {
+		if item.ItemId == req.ContainerItemId {
+			return s.unlock(p, i, "")
+		}
+	}
+	return nil, &Failure{ErrorCode: playfab.ErrContainerNotOwned, Message: "Container not owned"}
+}
+
+// unlock opens the container at index i of the inventory with a key, the
+// given key instance or else any instance of the key item. The container and
+// key each lose a use and their contents are granted.
+func (s *Server) unlock(p *player, i int, keyInstanceId string) (interface{}, *Failure) {
+	container := p.inventory[i]
+	catalogVersion := s.catalogVersion(container.CatalogVersion)
+	item, ok := s.catalogItem(catalogVersion, container.ItemId)
+	if !ok || item.Container == nil {
+		return nil, &Failure{ErrorCode: playfab.ErrInvalidContainerItem, Message: "Item is not a container"}
+	}
+	res := &playfab.UnlockContainerItemResult{UnlockedItemInstanceId: container.ItemInstanceId}
+	var key string
+	if item.Container.KeyItemId != "" {
+		for _, inst := range p.inventory {
+			if inst.ItemId == item.Container.KeyItemId && (keyInstanceId == "" || inst.ItemInstanceId == keyInstanceId) {
+				key = inst.ItemInstanceId
+				break
+			}
+		}
+		if key == "" {
+			return nil, &Failure{ErrorCode: playfab.ErrKeyNotOwned, Message: "Key not owned"}
+		}
+		res.UnlockedWithItemInstanceId = key
+	}
+
+	p.useOnce(container.ItemInstanceId)
+	if key != "" {
+		p.useOnce(key)
+	}
+	itemIds := append([]string(nil), item.Container.ItemContents...)
+	for _, tableId := range item.Container.ResultTableContents {
+		if items := s.randomTables[tableId]; len(items) > 0 {
+			itemIds = append(itemIds, items[rand.Intn(len(items))])
+		}
+	}
+	for _, g := range s.grant(p, catalogVersion, itemIds, "", "") {
+		res.GrantedItems = append(res.GrantedItems, g.ItemInstance)
+	}
+	for currency, amount := range item.Container.VirtualCurrencyContents {
+		p.currency[currency] += int32(amount)
+	}
+	res.VirtualCurrency = item.Container.VirtualCurrencyContents
+	return res, nil
+}
+
+// useOnce takes a use from an item instance, removing it when it has no uses
+// left or was not consumable.
+func (p *player) useOnce(itemInstanceId string) {
+	i := p.findInstance(itemInstanceId)
+	if i < 0 {
+		return
+	}
+	item := &p.inventory[i]
+	if item.RemainingUses != nil && *item.RemainingUses > 1 {
+		remaining := *item.RemainingUses - 1
+		item.RemainingUses = &remaining
+		return
+	}
+	p.inventory = append(p.inventory[:i], p.inventory[i+1:]...)
+}
+
+func redeemCoupon(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.RedeemCouponRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	c, ok := s.coupons[req.CouponCode]
+	if !ok {
+		return nil, &Failure{ErrorCode: playfab.ErrCouponCodeNotFound, Message: "Coupon code not found"}
+	}
+	if c.redeemed {
+		return nil, &Failure{ErrorCode: playfab.ErrCouponAlreadyRedeemed, Message: "Coupon already redeemed"}
+	}
+	c.redeemed = true
+	res := &playfab.RedeemCouponResult{GrantedItems: []playfab.ItemInstance{}}
+	for _, g := range s.grant(p, s.catalogVersion(req.CatalogVersion), c.itemIds, "", "") {
+		res.GrantedItems = append(res.GrantedItems, g.ItemInstance)
+	}
+	return res, nil
+}
+
+func revokeInventoryItems(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.RevokeInventoryItemsRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	res := &playfab.RevokeInventoryItemsResult{Errors: []playfab.RevokeItemError{}}
+	for _, item := range req.Items {
+		item := item
+		p, ok := s.players[item.PlayFabId]
+		i := -1
+		if ok {
+			i = p.findInstance(item.ItemInstanceId)
+		}
+		if i < 0 {
+			res.Errors = append(res.Errors, playfab.RevokeItemError{Item: &item, Error: playfab.ErrItemNotFound.String()})
+			continue
+		}
+		p.inventory = append(p.inventory[:i], p.inventory[i+1:]...)
+	}
+	return res, nil
+}
+
+func addUserVirtualCurrency(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.AddUserVirtualCurrencyRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	p.currency[req.VirtualCurrency] += req.Amount
+	return &playfab.ModifyUserVirtualCurrencyResult{
+		PlayFabId:       p.id,
+		VirtualCurrency: req.VirtualCurrency,
+		BalanceChange:   req.Amount,
+		Balance:         p.currency[req.VirtualCurrency],
+	}, nil
+}
+
+func subtractUserVirtualCurrency(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.SubtractUserVirtualCurrencyRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	// PlayFab lets the Server API take a balance below zero; callers check
+	// the returned Balance.
+	p.currency[req.VirtualCurrency] -= req.Amount
+	return &playfab.ModifyUserVirtualCurrencyResult{
+		PlayFabId:       p.id,
+		VirtualCurrency: req.VirtualCurrency,
+		BalanceChange:   -req.Amount,
+		Balance:         p.currency[req.VirtualCurrency],
+	}, nil
+}
+
+func getPlayerStatistics(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GetPlayerStatisticsRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	res := &playfab.GetPlayerStatisticsResult{PlayFabId: p.id, Statistics: []playfab.StatisticValue{}}
+	for _, v := range sortedStatistics(p.statistics) {
+		if s.wantStatistic(&req, v) {
+			res.Statistics = append(res.Statistics, v)
+		}
+	}
+	return res, nil
+}
+
+func (s *Server) wantStatistic(req *playfab.GetPlayerStatisticsRequest, v playfab.StatisticValue) bool {
+	if len(req.StatisticNameVersions) > 0 {
+		for _, nv := range req.StatisticNameVersions {
+			if nv.StatisticName == v.StatisticName && nv.Version == v.Version {
+				return true
+			}
+		}
+		return false
+	}
+	if v.Version != s.statVersions[v.StatisticName] {
+		return false
+	}
+	return len(req.StatisticNames) == 0 || contains(req.StatisticNames, v.StatisticName)
+}
+
+func getPlayerStatisticVersions(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GetPlayerStatisticVersionsRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	res := &playfab.GetPlayerStatisticVersionsResult{}
+	for v := uint32(0); v <= s.statVersions[req.StatisticName]; v++ {
+		res.StatisticVersions = append(res.StatisticVersions, playfab.PlayerStatisticVersion{
+			StatisticName: req.StatisticName,
+			Version:       v,
+		})
+	}
+	return res, nil
+}
+
+func updatePlayerStatistics(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.UpdatePlayerStatisticsRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	for _, u := range req.Statistics {
+		current := s.statVersions[u.StatisticName]
+		if u.Version != nil && *u.Version != current {
+			code := playfab.ErrStatisticVersionInvalid
+			if *u.Version < current {
+				code = playfab.ErrStatisticVersionClosedForWrites
+			}
+			return nil, &Failure{ErrorCode: code, Message: fmt.Sprintf("Statistic %s version %d", u.StatisticName, *u.Version)}
+		}
+	}
+	for _, u := range req.Statistics {
+		p.statistics[u.StatisticName] = playfab.StatisticValue{
+			StatisticName: u.StatisticName,
+			Value:         u.Value,
+			Version:       s.statVersions[u.StatisticName],
+		}
+	}
+	return nil, nil
+}
+
+// leaderboard ranks every player with a value for statName in version.
+func (s *Server) leaderboard(statName string, version uint32, constraints *playfab.PlayerProfileViewConstraints) []playfab.PlayerLeaderboardEntry {
+	entries := []playfab.PlayerLeaderboardEntry{}
+	for _, p := range s.players {
+		v, ok := p.statistics[statName]
+		if !ok || v.Version != version {
+			continue
+		}
+		entry := playfab.PlayerLeaderboardEntry{PlayFabId: p.id, StatValue: v.Value}
+		if constraints != nil {
+			entry.Profile = &playfab.PlayerProfileModel{PlayerId: p.id, TitleId: s.TitleId}
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].StatValue != entries[j].StatValue {
+			return entries[i].StatValue > entries[j].StatValue
+		}
+		return entries[i].PlayFabId < entries[j].PlayFabId
+	})
+	for i := range entries {
+		entries[i].Position = int32(i)
+	}
+	return entries
+}
+
+// leaderboardRequest holds the fields shared by the leaderboard requests as
+// they are sent, so the fake does not depend on how the client types them.
+type leaderboardRequest struct {
+	PlayFabId          string
+	StatisticName      string
+	StartPosition      int32
+	MaxResultsCount    int32
+	ProfileConstraints *playfab.PlayerProfileViewConstraints
+	UseSpecificVersion bool
+	Version            *uint32
+}
+
+func (s *Server) leaderboardVersion(req *leaderboardRequest) uint32 {
+	if req.UseSpecificVersion && req.Version != nil {
+		return *req.Version
+	}
+	return s.statVersions[req.StatisticName]
+}
+
+func page(entries []playfab.PlayerLeaderboardEntry, start int32, max int32) []playfab.PlayerLeaderboardEntry {
+	if max <= 0 {
+		max = 10
+	}
+	if int(start) >= len(entries) || start < 0 {
+		return []playfab.PlayerLeaderboardEntry{}
+	}
+	end := int(start + max)
+	if end > len(entries) {
+		end = len(entries)
+	}
+	return entries[start:end]
+}
+
+func getLeaderboard(s *Server, body []byte) (interface{}, *Failure) {
+	var req leaderboardRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	version := s.leaderboardVersion(&req)
+	entries := s.leaderboard(req.StatisticName, version, req.ProfileConstraints)
+	return &playfab.GetLeaderboardResult{
+		Leaderboard: page(entries, req.StartPosition, req.MaxResultsCount),
+		Version:     int32(version),
+	}, nil
+}
+
+func getLeaderboardAroundUser(s *Server, body []byte) (interface{}, *Failure) {
+	var req leaderboardRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	if _, f := s.player(req.PlayFabId); f != nil {
+		return nil, f
+	}
+	version := s.leaderboardVersion(&req)
+	entries := s.leaderboard(req.StatisticName, version, req.ProfileConstraints)
+	max := req.MaxResultsCount
+	if max <= 0 {
+		max = 10
+	}
+	start := int32(0)
+	for i, e := range entries {
+		if e.PlayFabId == req.PlayFabId {
+			start = int32(i) - (max-1)/2
+			break
+		}
+	}
+	if start < 0 {
+		start = 0
+	}
+	return &playfab.GetLeaderboardResult{
+		Leaderboard: page(entries, start, max),
+		Version:     int32(version),
+	}, nil
+}
+
+// getFriendLeaderboard ranks the player alone, the fake has no friend lists.
+func getFriendLeaderboard(s *Server, body []byte) (interface{}, *Failure) {
+	var req leaderboardRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	if _, f := s.player(req.PlayFabId); f != nil {
+		return nil, f
+	}
+	version := s.leaderboardVersion(&req)
+	friends := []playfab.PlayerLeaderboardEntry{}
+	for _, e := range s.leaderboard(req.StatisticName, version, req.ProfileConstraints) {
+		if e.PlayFabId == req.PlayFabId {
+			e.Position = int32(len(friends))
+			friends = append(friends, e)
+		}
+	}
+	return &playfab.GetLeaderboardResult{
+		Leaderboard: page(friends, req.StartPosition, req.MaxResultsCount),
+		Version:     int32(version),
+	}, nil
+}
+
+func getPlayerCombinedInfo(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GetPlayerCombinedInfoRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	params := req.InfoRequestParameters
+	payload := &playfab.GetPlayerCombinedInfoResultPayload{}
+	if params.GetUserAccountInfo {
+		payload.AccountInfo = &playfab.UserAccountInfo{PlayFabId: p.id, Created: p.created}
+	}
+	if params.GetPlayerProfile {
+		payload.PlayerProfile = &playfab.PlayerProfileModel{PlayerId: p.id, TitleId: s.TitleId, Created: &p.created}
+	}
+	if params.GetUserInventory {
+		payload.UserInventory = append([]playfab.ItemInstance{}, p.inventory...)
+	}
+	if params.GetUserVirtualCurrency {
+		payload.UserVirtualCurrency = copyCurrency(p.currency)
+		payload.UserVirtualCurrencyRechargeTimes = map[string]playfab.VirtualCurrencyRechargeTime{}
+	}
+	if params.GetUserData {
+		payload.UserData, payload.UserDataVersion = p.records("UserData", params.UserDataKeys)
+	}
+	if params.GetUserReadOnlyData {
+		payload.UserReadOnlyData, payload.UserReadOnlyDataVersion = p.records("UserReadOnlyData", params.UserReadOnlyDataKeys)
+	}
+	if params.GetTitleData {
+		payload.TitleData = copyStrings(s.titleData, params.TitleDataKeys)
+	}
+	if params.GetPlayerStatistics {
+		stats := &playfab.GetPlayerStatisticsRequest{StatisticNames: params.PlayerStatisticNames}
+		payload.PlayerStatistics = []playfab.StatisticValue{}
+		for _, v := range sortedStatistics(p.statistics) {
+			if s.wantStatistic(stats, v) {
+				payload.PlayerStatistics = append(payload.PlayerStatistics, v)
+			}
+		}
+	}
+	return &playfab.GetPlayerCombinedInfoResult{PlayFabId: p.id, InfoResultPayload: payload}, nil
+}
+
+func (p *player) records(kind string, keys []string) (map[string]playfab.UserDataRecord, uint32) {
+	d := p.userData(kind)
+	records := make(map[string]playfab.UserDataRecord)
+	for k, r := range d.records {
+		if len(keys) == 0 || contains(keys, k) {
+			records[k] = r
+		}
+	}
+	return records, d.version
+}
+
+func getTitleData(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GetTitleDataRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	data := copyStrings(s.titleData, req.Keys)
+	// An override replaces the keys it sets and falls back to the base
+	// title data for the others.
+	for k, v := range copyStrings(s.overrides[req.OverrideLabel], req.Keys) {
+		data[k] = v
+	}
+	return &playfab.GetTitleDataResult{Data: data}, nil
+}
+
+func getTitleInternalData(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GetTitleDataRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	return &playfab.GetTitleDataResult{Data: copyStrings(s.titleInternalData, req.Keys)}, nil
+}
+
+func setTitleData(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.SetTitleDataRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	setKey(s.titleData, req.Key, req.Value)
+	return nil, nil
+}
+
+func setTitleInternalData(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.SetTitleDataRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	setKey(s.titleInternalData, req.Key, req.Value)
+	return nil, nil
+}
+
+func getPublisherData(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GetPublisherDataRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	return &playfab.GetPublisherDataResult{Data: copyStrings(s.publisherData, req.Keys)}, nil
+}
+
+func setPublisherData(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.SetPublisherDataRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	setKey(s.publisherData, req.Key, req.Value)
+	return nil, nil
+}
+
+// setKey sets key in data, deleting it when value is empty as PlayFab does.
+func setKey(data map[string]string, key string, value string) {
+	if value == "" {
+		delete(data, key)
+		return
+	}
+	data[key] = value
+}
+
+func getCatalogItems(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GetCatalogItemsRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	return &playfab.GetCatalogItemsResult{
+		Catalog: append([]playfab.CatalogItem{}, s.catalogs[s.catalogVersion(req.CatalogVersion)]...),
+	}, nil
+}
+
+func getStoreItems(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GetStoreItemsRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	catalogVersion := s.catalogVersion(req.CatalogVersion)
+	store, ok := s.stores[catalogVersion][req.StoreId]
+	if !ok {
+		return nil, &Failure{ErrorCode: playfab.ErrStoreNotFound, Message: "Store not found"}
+	}
+	if req.PlayFabId != "" {
+		if _, f := s.player(req.PlayFabId); f != nil {
+			return nil, f
+		}
+	}
+	return &playfab.GetStoreItemsResult{
+		StoreId:        req.StoreId,
+		CatalogVersion: catalogVersion,
+		Store:          append([]playfab.StoreItem{}, store.Items...),
+		MarketingData:  store.MarketingData,
+		Source:         "Admin",
+	}, nil
+}
+
+func sendPushNotification(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.SendPushNotificationRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	if _, f := s.player(req.Recipient); f != nil {
+		return nil, f
+	}
+	s.pushes = append(s.pushes, req)
+	return nil, nil
+}
+
+func addPlayerTag(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.AddPlayerTagRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	if !contains(p.tags, req.TagName) {
+		p.tags = append(p.tags, req.TagName)
+	}
+	return nil, nil
+}
+
+func removePlayerTag(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.RemovePlayerTagRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	for i, tag := range p.tags {
+		if tag == req.TagName {
+			p.tags = append(p.tags[:i], p.tags[i+1:]...)
+			break
+		}
+	}
+	return nil, nil
+}
+
+// getPlayerTags returns tags in the title namespace, "title.{TitleId}.{tag}",
+// as PlayFab does.
+func getPlayerTags(s *Server, body []byte) (interface{}, *Failure) {
+	var req playfab.GetPlayerTagsRequest
+	if f := decode(body, &req); f != nil {
+		return nil, f
+	}
+	p, f := s.player(req.PlayFabId)
+	if f != nil {
+		return nil, f
+	}
+	namespace := "title." + s.TitleId
+	res := &playfab.GetPlayerTagsResult{PlayFabId: p.id, Tags: []string{}}
+	if req.Namespace != "" && req.Namespace != namespace {
+		return res, nil
+	}
+	for _, tag := range p.tags {
+		res.Tags = append(res.Tags, namespace+"."+tag)
+	}
+	return res, nil
+}
+
+func copyCurrency(currency map[string]int32) map[string]int32 {
+	res := make(map[string]int32, len(currency))
+	for k, v := range currency {
+		res[k] = v
+	}
+	return res
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
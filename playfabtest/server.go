@@ -0,0 +1,344 @@
+// Package playfabtest provides an in-process fake of PlayFab for testing code
+// that uses the playfab client.
+//
+// The fake keeps its state in memory and implements the Server and Admin
+// functions the client calls, the Client API logins and player calls, and
+// entity tokens. Failures can be queued per function to exercise
+// retries and error handling:
+//
+//	srv := playfabtest.NewServer()
+//	defer srv.Close()
+//	srv.SetVirtualCurrency("player", "GO", 10)
+//	srv.FailNext("SubtractUserVirtualCurrency", playfabtest.ServiceUnavailable)
+//	pf, _ := srv.NewClient("main")
+package playfabtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+)
+
+const (
+	DefaultTitleId   = "TEST"
+	DefaultSecretKey = "playfabtest-secret"
+)
+
+// handlers holds the functions called with the title secret, by API.
+var handlers = map[string]map[string]handler{
+	"Server": serverHandlers,
+	"Admin":  adminHandlers,
+}
+
+type Server struct {
+	*httptest.Server
+
+	TitleId   string
+	SecretKey string
+	// EntityTokenLifetime is how long issued entity tokens are valid,
+	// DefaultEntityTokenLifetime when zero.
+	EntityTokenLifetime time.Duration
+
+	mu                sync.Mutex
+	players           map[string]*player
+	titleData         map[string]string
+	titleInternalData map[string]string
+	overrides         map[string]map[string]string
+	publisherData     map[string]string
+	catalogs          map[string][]playfab.CatalogItem
+	primaryCatalog    string
+	stores            map[string]map[string]*Store
+	randomTables      map[string][]string
+	coupons           map[string]*coupon
+	currencyTypes     map[string]playfab.VirtualCurrencyData
+	statVersions      map[string]uint32
+	statDefinitions   map[string]playfab.PlayerStatisticDefinition
+	pushes            []playfab.SendPushNotificationRequest
+	accounts          map[string]string
+	passwords         map[string]string
+	sessions          map[string]string
+	entityTokens      map[string]entityToken
+	failures          map[string][]Failure
+	calls             map[string]int
+	requests          map[string][]json.RawMessage
+	nextInstanceId    int
+	nextPlayFabId     int
+	nextTicket        int
+}
+
+// Store is a store definition of a catalog, as set with SetStore.
+type Store struct {
+	Items         []playfab.StoreItem
+	MarketingData *playfab.StoreMarketingModel
+}
+
+// coupon is a single use coupon code, as added with AddCoupon.
+type coupon struct {
+	itemIds  []string
+	redeemed bool
+}
+
+type userData struct {
+	records map[string]playfab.UserDataRecord
+	version uint32
+}
+
+type player struct {
+	id         string
+	created    time.Time
+	data       map[string]*userData
+	inventory  []playfab.ItemInstance
+	currency   map[string]int32
+	tags       []string
+	statistics map[string]playfab.StatisticValue
+	characters map[string][]playfab.ItemInstance
+}
+
+// NewServer starts a fake PlayFab server. It must be closed with Close.
+func NewServer() *Server {
+	s := &Server{
+		TitleId:           DefaultTitleId,
+		SecretKey:         DefaultSecretKey,
+		players:           make(map[string]*player),
+		titleData:         make(map[string]string),
+		titleInternalData: make(map[string]string),
+		overrides:         make(map[string]map[string]string),
+		publisherData:     make(map[string]string),
+		catalogs:          make(map[string][]playfab.CatalogItem),
+		stores:            make(map[string]map[string]*Store),
+		randomTables:      make(map[string][]string),
+		coupons:           make(map[string]*coupon),
+		currencyTypes:     make(map[string]playfab.VirtualCurrencyData),
+		statVersions:      make(map[string]uint32),
+		statDefinitions:   make(map[string]playfab.PlayerStatisticDefinition),
+		accounts:          make(map[string]string),
+		passwords:         make(map[string]string),
+		sessions:          make(map[string]string),
+		entityTokens:      make(map[string]entityToken),
+		failures:          make(map[string][]Failure),
+		calls:             make(map[string]int),
+		requests:          make(map[string][]json.RawMessage),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient returns a playfab client that talks to the fake server. The
+// given options are applied after the ones pointing the client at s.
+func (s *Server) NewClient(catalogVersion string, opts ...playfab.Option) (*playfab.PlayFab, error) {
+	opts = append([]playfab.Option{
+		playfab.WithBaseURL(s.URL),
+		playfab.WithHTTPClient(s.Client()),
+	}, opts...)
+	return playfab.New(s.SecretKey, s.TitleId, catalogVersion, opts...)
+}
+
+// Calls returns how many times funcName was called, failed calls included.
+func (s *Server) Calls(funcName string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[funcName]
+}
+
+// Requests returns the bodies of the calls to funcName, failed calls
+// included, in the order they were made.
+func (s *Server) Requests(funcName string) []json.RawMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]json.RawMessage(nil), s.requests[funcName]...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) != 2 {
+		writeFailure(w, notFound(r.URL.Path))
+		return
+	}
+	api, funcName := parts[0], parts[1]
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeFailure(w, invalidParams(err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls[funcName]++
+	s.requests[funcName] = append(s.requests[funcName], json.RawMessage(body))
+	if queue := s.failures[funcName]; len(queue) > 0 {
+		s.failures[funcName] = queue[1:]
+		writeFailure(w, &queue[0])
+		return
+	}
+
+	data, f := s.handle(r, api, funcName, body)
+	if f != nil {
+		writeFailure(w, f)
+		return
+	}
+	if data == nil {
+		data = struct{}{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"code":   http.StatusOK,
+		"status": "OK",
+		"data":   data,
+	})
+}
+
+// handle authenticates a call the way its API requires and runs it. Callers
+// must hold s.mu.
+func (s *Server) handle(r *http.Request, api string, funcName string, body []byte) (interface{}, *Failure) {
+	if h, ok := handlers[api][funcName]; ok {
+		if r.Header.Get("X-SecretKey") != s.SecretKey {
+			return nil, invalidSecretKey()
+		}
+		return h(s, body)
+	}
+	if api == "Client" {
+		if h, ok := loginHandlers[funcName]; ok {
+			return h(s, body)
+		}
+		if h, ok := clientHandlers[funcName]; ok {
+			p, f := s.sessionPlayer(r.Header.Get("X-Authorization"))
+			if f != nil {
+				return nil, f
+			}
+			return h(s, p, body)
+		}
+	}
+	if api == "Authentication" && funcName == "GetEntityToken" {
+		return s.getEntityToken(r, body)
+	}
+	if h, ok := entityHandlers[api][funcName]; ok {
+		entity, f := s.entity(r.Header.Get("X-EntityToken"))
+		if f != nil {
+			return nil, f
+		}
+		return h(s, entity, body)
+	}
+	return nil, notFound(r.URL.Path)
+}
+
+func invalidSecretKey() *Failure {
+	return &Failure{
+		HTTPStatus: http.StatusUnauthorized,
+		ErrorCode:  playfab.ErrNotAuthenticated,
+		Message:    "Invalid secret key",
+	}
+}
+
+func writeFailure(w http.ResponseWriter, f *Failure) {
+	status := f.HTTPStatus
+	if status == 0 {
+		status = http.StatusBadRequest
+	}
+	if f.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int((f.RetryAfter+time.Second-1)/time.Second)))
+	}
+	if f.Raw != "" {
+		w.WriteHeader(status)
+		w.Write([]byte(f.Raw))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	statusName := strings.Replace(http.StatusText(status), " ", "", -1)
+	errorName := f.ErrorCode.String()
+	if f.ErrorCode == 0 {
+		errorName = statusName
+	}
+	res := map[string]interface{}{
+		"code":         status,
+		"status":       statusName,
+		"error":        errorName,
+		"errorCode":    int(f.ErrorCode),
+		"errorMessage": f.Message,
+	}
+	if len(f.Details) > 0 {
+		res["errorDetails"] = f.Details
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+func decode(body []byte, req interface{}) *Failure {
+	if err := json.Unmarshal(body, req); err != nil {
+		return invalidParams(err.Error())
+	}
+	return nil
+}
+
+func (s *Server) player(playFabId string) (*player, *Failure) {
+	p, ok := s.players[playFabId]
+	if !ok {
+		return nil, &Failure{
+			ErrorCode: playfab.ErrAccountNotFound,
+			Message:   fmt.Sprintf("User not found: %s", playFabId),
+		}
+	}
+	return p, nil
+}
+
+// ensurePlayer returns the player with the given id, creating it when needed.
+// Callers must hold s.mu.
+func (s *Server) ensurePlayer(playFabId string) *player {
+	p, ok := s.players[playFabId]
+	if !ok {
+		p = &player{
+			id:         playFabId,
+			created:    time.Now().UTC(),
+			data:       make(map[string]*userData),
+			currency:   make(map[string]int32),
+			statistics: make(map[string]playfab.StatisticValue),
+			characters: make(map[string][]playfab.ItemInstance),
+		}
+		s.players[playFabId] = p
+	}
+	return p
+}
+
+func (p *player) userData(kind string) *userData {
+	d, ok := p.data[kind]
+	if !ok {
+		d = &userData{records: make(map[string]playfab.UserDataRecord)}
+		p.data[kind] = d
+	}
+	return d
+}
+
+// catalogVersion resolves the catalog a request names, the primary catalog
+// when it names none. Callers must hold s.mu.
+func (s *Server) catalogVersion(catalogVersion string) string {
+	if catalogVersion == "" {
+		return s.primaryCatalog
+	}
+	return catalogVersion
+}
+
+// titleDataOverride returns the title data of an override, creating it when
+// needed. Callers must hold s.mu.
+func (s *Server) titleDataOverride(label string) map[string]string {
+	data, ok := s.overrides[label]
+	if !ok {
+		data = make(map[string]string)
+		s.overrides[label] = data
+	}
+	return data
+}
+
+func (s *Server) newInstanceId() string {
+	s.nextInstanceId++
+	return fmt.Sprintf("%016X", s.nextInstanceId)
+}
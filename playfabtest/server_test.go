@@ -0,0 +1,272 @@
+package playfabtest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func newServerWithGems(uses int32) (*playfabtest.Server, string) {
+	srv := playfabtest.NewServer()
+	srv.AddInventoryItems("player", playfab.ItemInstance{ItemId: "gem", RemainingUses: &uses})
+	return srv, srv.Inventory("player")[0].ItemInstanceId
+}
+
+func TestFailNext(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetVirtualCurrency("player", "GO", 1)
+	srv.FailNext("GetUserInventory", playfabtest.Conflict)
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+	req := &playfab.GetUserInventoryRequest{PlayFabId: "player"}
+
+	if _, err := pf.GetUserInventoryTyped(context.Background(), req); !errors.Is(err, playfab.ErrConcurrentEditError) {
+		t.Errorf("got %v, want the queued conflict", err)
+	}
+	res, err := pf.GetUserInventoryTyped(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.VirtualCurrency["GO"] != 1 {
+		t.Errorf("got %+v", res)
+	}
+	if n := srv.Calls("GetUserInventory"); n != 2 {
+		t.Errorf("got %d calls, want 2", n)
+	}
+}
+
+func TestConsumeItemRejectsNonPositiveCount(t *testing.T) {
+	srv, id := newServerWithGems(3)
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+
+	for _, count := range []int32{0, -2} {
+		_, err := pf.ConsumeItemTyped(context.Background(), &playfab.ConsumeItemRequest{
+			PlayFabId:      "player",
+			ItemInstanceId: id,
+			ConsumeCount:   count,
+		})
+		if !errors.Is(err, playfab.ErrInvalidParams) {
+			t.Errorf("consuming %d: got %v, want ErrInvalidParams", count, err)
+		}
+	}
+	if uses := *srv.Inventory("player")[0].RemainingUses; uses != 3 {
+		t.Errorf("gem has %d uses, want 3", uses)
+	}
+}
+
+func TestModifyItemUses(t *testing.T) {
+	srv, id := newServerWithGems(3)
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+	modify := func(n int32) error {
+		_, err := pf.ModifyItemUses(context.Background(), &playfab.ModifyItemUsesRequest{
+			PlayFabId:      "player",
+			ItemInstanceId: id,
+			UsesToAdd:      n,
+		})
+		return err
+	}
+
+	if err := modify(0); !errors.Is(err, playfab.ErrInvalidParams) {
+		t.Errorf("got %v, want ErrInvalidParams", err)
+	}
+	if err := modify(-4); !errors.Is(err, playfab.ErrNoRemainingUses) {
+		t.Errorf("got %v, want ErrNoRemainingUses", err)
+	}
+	if err := modify(-3); err != nil {
+		t.Fatal(err)
+	}
+	if inv := srv.Inventory("player"); len(inv) != 0 {
+		t.Errorf("got %+v, want the used up gem removed", inv)
+	}
+}
+
+func TestPrimaryCatalog(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetCatalog("v1", playfab.CatalogItem{ItemId: "sword"})
+	srv.SetCatalog("v2", playfab.CatalogItem{ItemId: "shield"})
+	srv.SetStore("v2", "shop", playfabtest.Store{Items: []playfab.StoreItem{{ItemId: "shield"}}})
+	srv.AddPlayer("player")
+	pf, _ := srv.NewClient("")
+	ctx := context.Background()
+
+	catalog, err := pf.GetCatalogItemsTyped(ctx, &playfab.GetCatalogItemsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(catalog.Catalog) != 1 || catalog.Catalog[0].ItemId != "sword" {
+		t.Errorf("got %+v, want the first catalog set", catalog.Catalog)
+	}
+
+	srv.SetPrimaryCatalog("v2")
+	grant, err := pf.GrantItemsToUserTyped(ctx, &playfab.GrantItemsToUserRequest{
+		PlayFabId: "player",
+		ItemIds:   []string{"shield"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := grant.ItemGrantResults; len(r) != 1 || !r[0].Result || r[0].CatalogVersion != "v2" {
+		t.Errorf("got %+v, want shield granted from v2", r)
+	}
+	store, err := pf.GetStoreItemsTyped(ctx, &playfab.GetStoreItemsRequest{StoreId: "shop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.CatalogVersion != "v2" || len(store.Store) != 1 {
+		t.Errorf("got %+v, want the v2 store", store)
+	}
+}
+
+func TestSubtractUserVirtualCurrencyGoesNegative(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetVirtualCurrency("player", "GO", 5)
+	pf, _ := srv.NewClient("main")
+
+	res, err := pf.SubtractUserVirtualCurrencyTyped(context.Background(), &playfab.SubtractUserVirtualCurrencyRequest{
+		PlayFabId:       "player",
+		VirtualCurrency: "GO",
+		Amount:          8,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Balance != -3 || srv.VirtualCurrency("player")["GO"] != -3 {
+		t.Errorf("got balance %d, want -3", res.Balance)
+	}
+}
+
+func TestTitleDataOverride(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetTitleData(map[string]string{"motd": "hello", "theme": "light"})
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	err := pf.Admin().SetTitleDataAndOverrides(ctx, &playfab.SetTitleDataAndOverridesRequest{
+		OverrideLabel: "winter",
+		KeyValues:     []playfab.TitleDataKeyValue{{Key: "theme", Value: "snow"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := pf.GetTitleDataTyped(ctx, &playfab.GetTitleDataRequest{OverrideLabel: "winter"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Data["motd"] != "hello" || res.Data["theme"] != "snow" {
+		t.Errorf("got %v, want the override on top of the title data", res.Data)
+	}
+	if theme := srv.TitleData()["theme"]; theme != "light" {
+		t.Errorf("base theme is %q, want light", theme)
+	}
+}
+
+func TestClientLoginAndPurchase(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetCatalog("main", playfab.CatalogItem{ItemId: "sword", VirtualCurrencyPrices: map[string]uint32{"GO": 5}})
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+	ctx := context.Background()
+
+	login := &playfab.LoginWithCustomIDRequest{CustomId: "bot-1", CreateAccount: true}
+	session, err := pf.Client().LoginWithCustomID(ctx, login)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !session.LoginResult().NewlyCreated {
+		t.Error("first login did not create the account")
+	}
+	again, err := pf.Client().LoginWithCustomID(ctx, login)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.PlayFabId() != session.PlayFabId() || again.LoginResult().NewlyCreated {
+		t.Errorf("second login got %+v, want the account of the first", again.LoginResult())
+	}
+
+	srv.SetVirtualCurrency(session.PlayFabId(), "GO", 7)
+	buy := &playfab.PurchaseItemRequest{ItemId: "sword", VirtualCurrency: "GO", Price: 4}
+	if _, err := session.PurchaseItem(ctx, buy); !errors.Is(err, playfab.ErrWrongPrice) {
+		t.Errorf("got %v, want ErrWrongPrice", err)
+	}
+	buy.Price = 5
+	if _, err := session.PurchaseItem(ctx, buy); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := session.PurchaseItem(ctx, buy); !errors.Is(err, playfab.ErrInsufficientFunds) {
+		t.Errorf("got %v, want ErrInsufficientFunds", err)
+	}
+	if balance := srv.VirtualCurrency(session.PlayFabId())["GO"]; balance != 2 {
+		t.Errorf("balance is %d, want 2", balance)
+	}
+	if inv := srv.Inventory(session.PlayFabId()); len(inv) != 1 || inv[0].ItemId != "sword" {
+		t.Errorf("got %+v, want one sword", inv)
+	}
+}
+
+func TestEntityTokenExpiry(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+
+	tok, err := pf.GetEntityToken(context.Background(), &playfab.GetEntityTokenRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.Entity == nil || tok.Entity.Type != "title" || tok.Entity.Id != srv.TitleId {
+		t.Errorf("got %+v, want the title entity", tok.Entity)
+	}
+	getProfile := func() int {
+		req, _ := http.NewRequest("POST", srv.URL+"/Profile/GetProfile", strings.NewReader("{}"))
+		req.Header.Set("X-EntityToken", tok.EntityToken)
+		res, err := srv.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		var body struct{ ErrorCode int }
+		json.NewDecoder(res.Body).Decode(&body)
+		return body.ErrorCode
+	}
+
+	if code := getProfile(); code != 0 {
+		t.Fatalf("got error code %d with a fresh token", code)
+	}
+	srv.ExpireEntityTokens()
+	if code := getProfile(); code != int(playfab.ErrEntityTokenExpired) {
+		t.Errorf("got error code %d, want EntityTokenExpired", code)
+	}
+}
+
+func TestRequests(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddPlayer("player")
+	srv.FailNext("AddPlayerTag", playfabtest.Conflict)
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+
+	for _, tag := range []string{"vip", "beta"} {
+		pf.AddPlayerTagTyped(context.Background(), &playfab.AddPlayerTagRequest{PlayFabId: "player", TagName: tag})
+	}
+	reqs := srv.Requests("AddPlayerTag")
+	if len(reqs) != 2 {
+		t.Fatalf("got %d requests, want 2", len(reqs))
+	}
+	var req playfab.AddPlayerTagRequest
+	if err := json.Unmarshal(reqs[1], &req); err != nil {
+		t.Fatal(err)
+	}
+	if req.TagName != "beta" {
+		t.Errorf("got %+v, want the second tag", req)
+	}
+}
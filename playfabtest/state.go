@@ -0,0 +1,389 @@
+package playfabtest
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+)
+
+// The setters below seed the fake server and create players on demand. The
+// getters return copies of the current state for assertions.
+
+func (s *Server) AddPlayer(playFabId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ensurePlayer(playFabId)
+}
+
+func (s *Server) SetUserInternalData(playFabId string, data map[string]string) {
+	s.setUserData(playFabId, "UserInternalData", data)
+}
+
+func (s *Server) SetUserReadOnlyData(playFabId string, data map[string]string) {
+	s.setUserData(playFabId, "UserReadOnlyData", data)
+}
+
+func (s *Server) UserInternalData(playFabId string) map[string]string {
+	return s.userDataValues(playFabId, "UserInternalData")
+}
+
+func (s *Server) UserReadOnlyData(playFabId string) map[string]string {
+	return s.userDataValues(playFabId, "UserReadOnlyData")
+}
+
+// SetUserDataRecords sets values in any kind of the player's data.
+func (s *Server) SetUserDataRecords(playFabId string, kind playfab.UserDataKind, data map[string]string) {
+	s.setUserData(playFabId, string(kind), data)
+}
+
+func (s *Server) UserDataRecords(playFabId string, kind playfab.UserDataKind) map[string]string {
+	return s.userDataValues(playFabId, string(kind))
+}
+
+func (s *Server) setUserData(playFabId string, kind string, data map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.ensurePlayer(playFabId).userData(kind)
+	now := time.Now().UTC()
+	for k, v := range data {
+		d.records[k] = playfab.UserDataRecord{
+			Value:       v,
+			LastUpdated: now,
+			Permission:  playfab.UserDataPermissionPrivate,
+		}
+	}
+	d.version++
+}
+
+func (s *Server) userDataValues(playFabId string, kind string) map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.players[playFabId]
+	if !ok {
+		return nil
+	}
+	values := make(map[string]string)
+	for k, r := range p.userData(kind).records {
+		values[k] = r.Value
+	}
+	return values
+}
+
+// AddInventoryItems puts items in the player's inventory as they are,
+// assigning an ItemInstanceId to those without one.
+func (s *Server) AddInventoryItems(playFabId string, items ...playfab.ItemInstance) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.ensurePlayer(playFabId)
+	for _, item := range items {
+		if item.ItemInstanceId == "" {
+			item.ItemInstanceId = s.newInstanceId()
+		}
+		p.inventory = append(p.inventory, item)
+	}
+}
+
+func (s *Server) Inventory(playFabId string) []playfab.ItemInstance {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.players[playFabId]
+	if !ok {
+		return nil
+	}
+	return append([]playfab.ItemInstance(nil), p.inventory...)
+}
+
+// AddCharacter gives the player a character with an empty inventory.
+func (s *Server) AddCharacter(playFabId string, characterId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.ensurePlayer(playFabId)
+	if _, ok := p.characters[characterId]; !ok {
+		p.characters[characterId] = nil
+	}
+}
+
+// CharacterInventory returns the items of a character of the player.
+func (s *Server) CharacterInventory(playFabId string, characterId string) []playfab.ItemInstance {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.players[playFabId]
+	if !ok {
+		return nil
+	}
+	return append([]playfab.ItemInstance(nil), p.characters[characterId]...)
+}
+
+func (s *Server) SetVirtualCurrency(playFabId string, currency string, balance int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ensurePlayer(playFabId).currency[currency] = balance
+}
+
+func (s *Server) VirtualCurrency(playFabId string) map[string]int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.players[playFabId]
+	if !ok {
+		return nil
+	}
+	balances := make(map[string]int32, len(p.currency))
+	for k, v := range p.currency {
+		balances[k] = v
+	}
+	return balances
+}
+
+func (s *Server) SetTags(playFabId string, tags ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ensurePlayer(playFabId).tags = append([]string(nil), tags...)
+}
+
+func (s *Server) Tags(playFabId string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.players[playFabId]
+	if !ok {
+		return nil
+	}
+	return append([]string(nil), p.tags...)
+}
+
+// SetStatistic sets a player statistic in the current version of statName.
+func (s *Server) SetStatistic(playFabId string, statName string, value int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ensurePlayer(playFabId).statistics[statName] = playfab.StatisticValue{
+		StatisticName: statName,
+		Value:         value,
+		Version:       s.statVersions[statName],
+	}
+}
+
+func (s *Server) Statistics(playFabId string) []playfab.StatisticValue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.players[playFabId]
+	if !ok {
+		return nil
+	}
+	return sortedStatistics(p.statistics)
+}
+
+// SetStatisticVersion moves statName to a new version. Values written to
+// older versions are no longer returned.
+func (s *Server) SetStatisticVersion(statName string, version uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statVersions[statName] = version
+}
+
+func (s *Server) SetTitleData(data map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range data {
+		s.titleData[k] = v
+	}
+}
+
+func (s *Server) SetTitleInternalData(data map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range data {
+		s.titleInternalData[k] = v
+	}
+}
+
+func (s *Server) TitleData() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyStrings(s.titleData, nil)
+}
+
+func (s *Server) TitleInternalData() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyStrings(s.titleInternalData, nil)
+}
+
+// SetTitleDataOverride sets keys of the title data override named label.
+func (s *Server) SetTitleDataOverride(label string, data map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	override := s.titleDataOverride(label)
+	for k, v := range data {
+		override[k] = v
+	}
+}
+
+// TitleDataOverride returns the keys the override named label sets.
+func (s *Server) TitleDataOverride(label string) map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyStrings(s.overrides[label], nil)
+}
+
+func (s *Server) SetPublisherData(data map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range data {
+		s.publisherData[k] = v
+	}
+}
+
+func (s *Server) PublisherData() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyStrings(s.publisherData, nil)
+}
+
+// SetCatalog replaces the items of a catalog version. The first catalog set
+// becomes the primary catalog, used by requests that name no catalog.
+func (s *Server) SetCatalog(catalogVersion string, items ...playfab.CatalogItem) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.primaryCatalog == "" {
+		s.primaryCatalog = catalogVersion
+	}
+	catalog := make([]playfab.CatalogItem, len(items))
+	for i, item := range items {
+		item.CatalogVersion = catalogVersion
+		catalog[i] = item
+	}
+	s.catalogs[catalogVersion] = catalog
+}
+
+// SetPrimaryCatalog makes catalogVersion the title's primary catalog.
+func (s *Server) SetPrimaryCatalog(catalogVersion string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.primaryCatalog = catalogVersion
+}
+
+// Catalog returns the items of a catalog version, or of the primary catalog
+// when catalogVersion is empty.
+func (s *Server) Catalog(catalogVersion string) []playfab.CatalogItem {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]playfab.CatalogItem(nil), s.catalogs[s.catalogVersion(catalogVersion)]...)
+}
+
+func (s *Server) PrimaryCatalog() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.primaryCatalog
+}
+
+func (s *Server) SetStore(catalogVersion string, storeId string, store Store) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stores[catalogVersion] == nil {
+		s.stores[catalogVersion] = make(map[string]*Store)
+	}
+	s.stores[catalogVersion][storeId] = &store
+}
+
+// Store returns a store of a catalog version, or of the primary catalog when
+// catalogVersion is empty.
+func (s *Server) Store(catalogVersion string, storeId string) (Store, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	store, ok := s.stores[s.catalogVersion(catalogVersion)][storeId]
+	if !ok {
+		return Store{}, false
+	}
+	return Store{
+		Items:         append([]playfab.StoreItem(nil), store.Items...),
+		MarketingData: store.MarketingData,
+	}, true
+}
+
+// AddCoupon adds a single use coupon code that grants itemIds from the
+// primary catalog, or the catalog the redeem call names.
+func (s *Server) AddCoupon(code string, itemIds ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.coupons[code] = &coupon{itemIds: append([]string(nil), itemIds...)}
+}
+
+// SetRandomResultTable defines a table that evaluates to one of itemIds,
+// picked with equal weight.
+func (s *Server) SetRandomResultTable(tableId string, itemIds ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.randomTables[tableId] = append([]string(nil), itemIds...)
+}
+
+// RandomResultTable returns the item ids a table evaluates to.
+func (s *Server) RandomResultTable(tableId string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.randomTables[tableId]...)
+}
+
+// VirtualCurrencyTypes returns the currencies added with the Admin API, by
+// currency code.
+func (s *Server) VirtualCurrencyTypes() map[string]playfab.VirtualCurrencyData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := make(map[string]playfab.VirtualCurrencyData, len(s.currencyTypes))
+	for code, vc := range s.currencyTypes {
+		res[code] = vc
+	}
+	return res
+}
+
+// InvalidateSessionTickets forgets every session ticket issued so far, as
+// when they expire. Client calls with them fail until the player logs in.
+func (s *Server) InvalidateSessionTickets() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = make(map[string]string)
+}
+
+// ExpireEntityTokens makes every entity token issued so far expired.
+func (s *Server) ExpireEntityTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expired := time.Now().Add(-time.Second)
+	for token, tok := range s.entityTokens {
+		tok.expires = expired
+		s.entityTokens[token] = tok
+	}
+}
+
+func (s *Server) PushNotifications() []playfab.SendPushNotificationRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]playfab.SendPushNotificationRequest(nil), s.pushes...)
+}
+
+func sortedStatistics(stats map[string]playfab.StatisticValue) []playfab.StatisticValue {
+	res := make([]playfab.StatisticValue, 0, len(stats))
+	for _, v := range stats {
+		res = append(res, v)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].StatisticName < res[j].StatisticName
+	})
+	return res
+}
+
+// copyStrings copies data, keeping only keys when it is not empty.
+func copyStrings(data map[string]string, keys []string) map[string]string {
+	res := make(map[string]string)
+	if len(keys) == 0 {
+		for k, v := range data {
+			res[k] = v
+		}
+		return res
+	}
+	for _, k := range keys {
+		if v, ok := data[k]; ok {
+			res[k] = v
+		}
+	}
+	return res
+}
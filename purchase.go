@@ -0,0 +1,393 @@
+package playfab
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// compensationAttempts bounds how many times each call undoing a failed
+// purchase is made.
+const compensationAttempts = 5
+
+// PriceSource gives the virtual currency price of an item. *CatalogCache and
+// *Store are price sources.
+type PriceSource interface {
+	Price(itemId string, currency string) (uint32, bool)
+}
+
+type PurchaseRequest struct {
+	PlayFabId       string
+	ItemId          string
+	VirtualCurrency string
+	// Price is the price the player agreed to. The purchase fails with
+	// ErrWrongPrice when it differs from the current one.
+	Price          uint32
+	StoreId        string
+	CatalogVersion string
+	// Annotation is set on the granted items, followed by an id unique to
+	// the purchase that tells its items apart in the inventory.
+	Annotation string
+}
+
+type PurchaseResult struct {
+	Items []GrantedItemInstance
+	// Balance is the player's balance of VirtualCurrency after the debit.
+	Balance int32
+}
+
+// PurchaseError is returned when a purchase failed after part of it was
+// applied. CompensationErr is set when undoing that part failed as well, in
+// which case the player's inventory or balance needs fixing by hand.
+//
+// Unknown is set when the debit, or the grant, failed without telling
+// whether it was applied, and for a grant the inventory could not settle it
+// either. Nothing is undone then: the player may or may not have been
+// debited, or may or may not hold the item, which needs reconciling by hand.
+// PurchaseId is the id in the annotation of the items, if they were granted.
+type PurchaseError struct {
+	Err             error
+	CompensationErr error
+	Unknown         bool
+	PurchaseId      string
+}
+
+func (e *PurchaseError) Error() string {
+	if e.Unknown {
+		return fmt.Sprintf("purchase %s failed with an unknown outcome and needs reconciling: %v", e.PurchaseId, e.Err)
+	}
+	if e.CompensationErr == nil {
+		return fmt.Sprintf("purchase failed and was rolled back: %v", e.Err)
+	}
+	return fmt.Sprintf("purchase failed: %v; rolling back failed: %v", e.Err, e.CompensationErr)
+}
+
+func (e *PurchaseError) Unwrap() error {
+	return e.Err
+}
+
+// Purchase sells an item to a player for virtual currency on the server. The
+// price is checked against prices, or against the store, or the catalog when
+// prices is nil and no StoreId is given. The currency is then debited and the
+// item granted. The Server API lets balances go negative, so a debit that
+// leaves the player short is refunded and fails with ErrInsufficientFunds.
+// When the grant fails the granted bundle contents, if any, are taken back
+// and the debit refunded.
+//
+// A debit whose outcome is unknown returns a *PurchaseError with Unknown set,
+// as refunding a debit that was never applied would hand out currency.
+//
+// A grant whose outcome is unknown, such as one that timed out, is looked up
+// in the inventory by the purchase id in its annotation. The purchase
+// succeeds when the item is there and is rolled back when it can't be. When
+// neither can be told, such as for a stackable item the player already had,
+// a *PurchaseError with Unknown set is returned and nothing is refunded.
+func (pf *PlayFab) Purchase(ctx context.Context, prices PriceSource, req *PurchaseRequest) (*PurchaseResult, error) {
+	r := *req
+	r.CatalogVersion = pf.catalog(r.CatalogVersion)
+
+	price, err := pf.purchasePrice(ctx, prices, &r)
+	if err != nil {
+		return nil, err
+	}
+	if price != r.Price {
+		return nil, &PlayFabError{
+			Method:    "Purchase",
+			ErrorCode: int(ErrWrongPrice),
+			ErrorMsg:  fmt.Sprintf("%s costs %d %s, not %d", r.ItemId, price, r.VirtualCurrency, r.Price),
+		}
+	}
+	if price > math.MaxInt32 {
+		return nil, &PlayFabError{
+			Method:    "Purchase",
+			ErrorCode: int(ErrInvalidParams),
+			ErrorMsg:  fmt.Sprintf("%s costs %d %s, more than a balance can hold", r.ItemId, price, r.VirtualCurrency),
+		}
+	}
+
+	purchaseId, err := newPurchaseId()
+	if err != nil {
+		return nil, err
+	}
+	annotation := strings.TrimSpace(r.Annotation + " purchase:" + purchaseId)
+
+	res := &PurchaseResult{}
+	if price > 0 {
+		balances, err := pf.GetVirtualCurrencyBalances(ctx, r.PlayFabId)
+		if err != nil {
+			return nil, err
+		}
+		if balances.VirtualCurrency[r.VirtualCurrency] < int32(price) {
+			return nil, insufficientFunds(&r, price)
+		}
+
+		debit, err := pf.SubtractUserVirtualCurrencyTyped(ctx, &SubtractUserVirtualCurrencyRequest{
+			PlayFabId:       r.PlayFabId,
+			VirtualCurrency: r.VirtualCurrency,
+			Amount:          int32(price),
+		})
+		if err != nil {
+			if rejected(err) {
+				return nil, err
+			}
+			pf.logger.Error("Failed to debit %s for purchase %s with an unknown outcome, it needs reconciling: %v", r.PlayFabId, purchaseId, err)
+			return nil, &PurchaseError{Err: err, Unknown: true, PurchaseId: purchaseId}
+		}
+		res.Balance = debit.Balance
+		// Another debit may have spent the balance since it was read.
+		if debit.Balance < 0 {
+			err := insufficientFunds(&r, price)
+			pf.logger.Warn("Debiting %s left a balance of %d %s, refunding: %v", r.PlayFabId, debit.Balance, r.VirtualCurrency, err)
+			return nil, &PurchaseError{Err: err, CompensationErr: pf.compensatePurchase(context.Background(), &r, price, nil), PurchaseId: purchaseId}
+		}
+	}
+
+	grant, err := pf.GrantItemsToUserTyped(ctx, &GrantItemsToUserRequest{
+		PlayFabId:      r.PlayFabId,
+		ItemIds:        []string{r.ItemId},
+		CatalogVersion: r.CatalogVersion,
+		Annotation:     annotation,
+	})
+	if err == nil {
+		err = grantFailure(grant)
+		if err == nil {
+			res.Items = grant.ItemGrantResults
+			return res, nil
+		}
+	}
+
+	// The rollback, and the lookup before it, must run even when ctx is what
+	// made the grant fail.
+	if grant == nil && !rejected(err) {
+		items, applied, known := pf.findPurchase(context.Background(), &r, annotation)
+		if !known {
+			pf.logger.Error("Failed to grant %s to %s with an unknown outcome, purchase %s needs reconciling: %v", r.ItemId, r.PlayFabId, purchaseId, err)
+			return nil, &PurchaseError{Err: err, Unknown: true, PurchaseId: purchaseId}
+		}
+		if applied {
+			res.Items = items
+			return res, nil
+		}
+	}
+	pf.logger.Warn("Failed to grant %s to %s, rolling back the purchase: %v", r.ItemId, r.PlayFabId, err)
+	return nil, &PurchaseError{Err: err, CompensationErr: pf.compensatePurchase(context.Background(), &r, price, grant), PurchaseId: purchaseId}
+}
+
+func (pf *PlayFab) purchasePrice(ctx context.Context, prices PriceSource, r *PurchaseRequest) (uint32, error) {
+	if prices == nil && r.StoreId != "" {
+		store, err := pf.GetFullStore(ctx, &GetStoreItemsRequest{
+			StoreId:        r.StoreId,
+			CatalogVersion: r.CatalogVersion,
+			PlayFabId:      r.PlayFabId,
+		})
+		if err != nil {
+			return 0, err
+		}
+		prices = store
+	}
+	if prices == nil {
+		catalog, err := pf.GetCatalogItemsTyped(ctx, &GetCatalogItemsRequest{CatalogVersion: r.CatalogVersion})
+		if err != nil {
+			return 0, err
+		}
+		prices = catalogPrices(catalog.Catalog)
+	}
+
+	price, ok := prices.Price(r.ItemId, r.VirtualCurrency)
+	if !ok {
+		return 0, &PlayFabError{
+			Method:    "Purchase",
+			ErrorCode: int(ErrItemNotFound),
+			ErrorMsg:  fmt.Sprintf("%s has no price in %s", r.ItemId, r.VirtualCurrency),
+		}
+	}
+	return price, nil
+}
+
+// findPurchase looks for the items of a grant whose outcome is unknown. It
+// reports whether the grant was applied, and whether that could be told at
+// all: a grant that only added uses to a stack the player already had leaves
+// no annotated instance behind.
+func (pf *PlayFab) findPurchase(ctx context.Context, r *PurchaseRequest, annotation string) ([]GrantedItemInstance, bool, bool) {
+	inv, err := pf.GetUserInventoryTyped(ctx, &GetUserInventoryRequest{PlayFabId: r.PlayFabId})
+	if err != nil {
+		pf.logger.Warn("Failed to read the inventory of %s to settle a purchase: %v", r.PlayFabId, err)
+		return nil, false, false
+	}
+	var items []GrantedItemInstance
+	stacked := false
+	for _, item := range inv.Inventory {
+		if item.Annotation == annotation {
+			items = append(items, GrantedItemInstance{ItemInstance: item, PlayFabId: r.PlayFabId, Result: true})
+		} else if item.ItemId == r.ItemId && item.RemainingUses != nil {
+			stacked = true
+		}
+	}
+	if len(items) > 0 {
+		return items, true, true
+	}
+	return nil, false, !stacked
+}
+
+// compensatePurchase takes back what a failed grant gave and refunds the
+// debit. Uses added to a stack the player already had are removed from it;
+// instances the grant created are revoked. Every step is attempted even when
+// an earlier one failed, and the failures are returned together.
+func (pf *PlayFab) compensatePurchase(ctx context.Context, r *PurchaseRequest, price uint32, grant *GrantItemsToUserResult) error {
+	var errs compensationErrors
+	if grant != nil {
+		revoke := &RevokeInventoryItemsRequest{}
+		for _, item := range grant.ItemGrantResults {
+			if !item.Result || item.ItemInstanceId == "" {
+				continue
+			}
+			if addedToStack(&item.ItemInstance) {
+				err := pf.compensate(ctx, func() error {
+					_, err := pf.ModifyItemUses(ctx, &ModifyItemUsesRequest{
+						PlayFabId:      r.PlayFabId,
+						ItemInstanceId: item.ItemInstanceId,
+						UsesToAdd:      -*item.UsesIncrementedBy,
+					})
+					return err
+				})
+				if err != nil {
+					errs = append(errs, fmt.Errorf("failed to remove %d uses from %s: %v", *item.UsesIncrementedBy, item.ItemInstanceId, err))
+				}
+				continue
+			}
+			revoke.Items = append(revoke.Items, RevokeInventoryItem{
+				PlayFabId:      r.PlayFabId,
+				ItemInstanceId: item.ItemInstanceId,
+			})
+		}
+		if len(revoke.Items) > 0 {
+			err := pf.compensate(ctx, func() error {
+				res, err := pf.RevokeInventoryItemsTyped(ctx, revoke)
+				if err != nil {
+					return err
+				}
+				failed := 0
+				for _, e := range res.Errors {
+					// An item already gone, revoked by an earlier attempt or
+					// consumed, has nothing left to take back.
+					if e.Error != ErrItemNotFound.String() {
+						failed++
+					}
+				}
+				if failed > 0 {
+					return fmt.Errorf("failed to revoke %d granted items", failed)
+				}
+				return nil
+			})
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if price > 0 {
+		err := pf.compensate(ctx, func() error {
+			_, err := pf.AddUserVirtualCurrencyTyped(ctx, &AddUserVirtualCurrencyRequest{
+				PlayFabId:       r.PlayFabId,
+				VirtualCurrency: r.VirtualCurrency,
+				Amount:          int32(price),
+			})
+			return err
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to refund %d %s: %v", price, r.VirtualCurrency, err))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// compensationErrors are the failed steps of undoing a purchase.
+type compensationErrors []error
+
+func (e compensationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// compensate makes a call undoing part of a failed purchase, retrying it
+// even though such calls are not idempotent: leaving the player debited is
+// worse than a duplicate. Only errors PlayFab answered with are retried, a
+// call lost in transport may have been applied.
+func (pf *PlayFab) compensate(ctx context.Context, call func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := call()
+		var pfErr *PlayFabError
+		if err == nil || attempt >= compensationAttempts || !errors.As(err, &pfErr) || pfErr.RespCode == 0 || !IsRetryable(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(pf.retry.Backoff(attempt, err)):
+		}
+	}
+}
+
+// addedToStack reports whether a granted item is a stack the player already
+// had, which PlayFab returns with the uses it added.
+func addedToStack(item *ItemInstance) bool {
+	return item.UsesIncrementedBy != nil && item.RemainingUses != nil && *item.RemainingUses > *item.UsesIncrementedBy
+}
+
+// rejected reports whether PlayFab answered a call with an error that
+// means it was not applied.
+func rejected(err error) bool {
+	var pfErr *PlayFabError
+	return errors.As(err, &pfErr) && pfErr.RespCode >= 400 && pfErr.RespCode < 500
+}
+
+func insufficientFunds(r *PurchaseRequest, price uint32) error {
+	return &PlayFabError{
+		Method:    "Purchase",
+		ErrorCode: int(ErrInsufficientFunds),
+		ErrorMsg:  fmt.Sprintf("%s costs %d %s, more than %s has", r.ItemId, price, r.VirtualCurrency, r.PlayFabId),
+	}
+}
+
+func newPurchaseId() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func grantFailure(res *GrantItemsToUserResult) error {
+	for _, item := range res.ItemGrantResults {
+		if !item.Result {
+			return &PlayFabError{
+				Method:    "GrantItemsToUser",
+				ErrorCode: ErrUnknown,
+				ErrorMsg:  fmt.Sprintf("failed to grant %s", item.ItemId),
+			}
+		}
+	}
+	return nil
+}
+
+type catalogPrices []CatalogItem
+
+func (c catalogPrices) Price(itemId string, currency string) (uint32, bool) {
+	for _, item := range c {
+		if item.ItemId == itemId {
+			price, ok := item.VirtualCurrencyPrices[currency]
+			return price, ok
+		}
+	}
+	return 0, false
+}
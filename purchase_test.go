@@ -0,0 +1,513 @@
+package playfab_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func int32p(n int32) *int32 { return &n }
+
+// newPurchaseServer sells a sword for 5 GO and a pack of gems, whose second
+// item is missing from the catalog so that granting the pack partly fails.
+func newPurchaseServer() *playfabtest.Server {
+	srv := playfabtest.NewServer()
+	srv.SetCatalog("main",
+		playfab.CatalogItem{ItemId: "sword", VirtualCurrencyPrices: map[string]uint32{"GO": 5}},
+		playfab.CatalogItem{ItemId: "gem", IsStackable: true, VirtualCurrencyPrices: map[string]uint32{"GO": 1}},
+		playfab.CatalogItem{
+			ItemId:                "pack",
+			VirtualCurrencyPrices: map[string]uint32{"GO": 3},
+			Bundle:                &playfab.CatalogItemBundleInfo{BundledItems: []string{"gem", "missing"}},
+		},
+	)
+	srv.SetVirtualCurrency("player", "GO", 10)
+	return srv
+}
+
+func ownGems(srv *playfabtest.Server, uses int32) {
+	srv.AddInventoryItems("player", playfab.ItemInstance{
+		ItemId:         "gem",
+		CatalogVersion: "main",
+		RemainingUses:  int32p(uses),
+	})
+}
+
+func gemUses(t *testing.T, srv *playfabtest.Server) int32 {
+	t.Helper()
+	for _, item := range srv.Inventory("player") {
+		if item.ItemId == "gem" {
+			return *item.RemainingUses
+		}
+	}
+	return 0
+}
+
+// lostGrantTransport applies GrantItemsToUser on the server but loses the
+// response, as a timeout after PlayFab handled the call would.
+type lostGrantTransport struct {
+	base http.RoundTripper
+}
+
+func (t lostGrantTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.base.RoundTrip(req)
+	if err != nil || !strings.HasSuffix(req.URL.Path, "/GrantItemsToUser") {
+		return res, err
+	}
+	ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	return nil, errors.New("response lost")
+}
+
+func newLostGrantClient(t *testing.T, srv *playfabtest.Server) *playfab.PlayFab {
+	pf, err := srv.NewClient("main",
+		playfab.WithHTTPClient(&http.Client{Transport: lostGrantTransport{srv.Client().Transport}}),
+		playfab.WithRetryPolicy(playfab.NoRetry()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pf
+}
+
+func TestPurchase(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+
+	res, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "sword",
+		VirtualCurrency: "GO",
+		Price:           5,
+		Annotation:      "shop",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Balance != 5 || len(res.Items) != 1 || res.Items[0].ItemId != "sword" {
+		t.Fatalf("got %+v", res)
+	}
+	if !strings.HasPrefix(res.Items[0].Annotation, "shop purchase:") {
+		t.Errorf("annotation %q has no purchase id", res.Items[0].Annotation)
+	}
+}
+
+func TestPurchaseWrongPrice(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "sword",
+		VirtualCurrency: "GO",
+		Price:           4,
+	})
+	if !errors.Is(err, playfab.ErrWrongPrice) {
+		t.Fatalf("got %v, want ErrWrongPrice", err)
+	}
+	if srv.Calls("SubtractUserVirtualCurrency") != 0 {
+		t.Error("player was debited")
+	}
+}
+
+func TestPurchaseRollbackKeepsOwnedStack(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	ownGems(srv, 5)
+	pf, _ := srv.NewClient("main")
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "pack",
+		VirtualCurrency: "GO",
+		Price:           3,
+	})
+	var pErr *playfab.PurchaseError
+	if !errors.As(err, &pErr) || pErr.CompensationErr != nil || pErr.Unknown {
+		t.Fatalf("got %v, want a rolled back PurchaseError", err)
+	}
+	inv := srv.Inventory("player")
+	if len(inv) != 1 || gemUses(t, srv) != 5 {
+		t.Errorf("inventory after rollback is %+v, want the 5 owned gems", inv)
+	}
+	if balance := srv.VirtualCurrency("player")["GO"]; balance != 10 {
+		t.Errorf("balance is %d, want the refunded 10", balance)
+	}
+}
+
+func TestPurchaseRollbackRetriesRefund(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	srv.FailNext("AddUserVirtualCurrency", playfabtest.ServiceUnavailable, playfabtest.ServiceUnavailable)
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "pack",
+		VirtualCurrency: "GO",
+		Price:           3,
+	})
+	var pErr *playfab.PurchaseError
+	if !errors.As(err, &pErr) || pErr.CompensationErr != nil {
+		t.Fatalf("got %v, want a rolled back PurchaseError", err)
+	}
+	if n := srv.Calls("AddUserVirtualCurrency"); n != 3 {
+		t.Errorf("refund was attempted %d times, want 3", n)
+	}
+	if balance := srv.VirtualCurrency("player")["GO"]; balance != 10 {
+		t.Errorf("balance is %d, want the refunded 10", balance)
+	}
+}
+
+func TestPurchaseGrantRejectedIsRefunded(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	srv.FailNext("GrantItemsToUser", playfabtest.Failure{ErrorCode: playfab.ErrInvalidParams})
+	pf, _ := srv.NewClient("main")
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "sword",
+		VirtualCurrency: "GO",
+		Price:           5,
+	})
+	var pErr *playfab.PurchaseError
+	if !errors.As(err, &pErr) || pErr.Unknown {
+		t.Fatalf("got %v, want a rolled back PurchaseError", err)
+	}
+	if srv.Calls("GetUserInventory") != 0 {
+		t.Error("inventory was read for a grant PlayFab rejected")
+	}
+	if balance := srv.VirtualCurrency("player")["GO"]; balance != 10 {
+		t.Errorf("balance is %d, want the refunded 10", balance)
+	}
+}
+
+func TestPurchaseUnknownGrantNotApplied(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	srv.FailNext("GrantItemsToUser", playfabtest.ServiceUnavailable)
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "sword",
+		VirtualCurrency: "GO",
+		Price:           5,
+	})
+	var pErr *playfab.PurchaseError
+	if !errors.As(err, &pErr) || pErr.Unknown {
+		t.Fatalf("got %v, want a rolled back PurchaseError", err)
+	}
+	if balance := srv.VirtualCurrency("player")["GO"]; balance != 10 {
+		t.Errorf("balance is %d, want the refunded 10", balance)
+	}
+}
+
+func TestPurchaseUnknownGrantApplied(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	pf := newLostGrantClient(t, srv)
+
+	res, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "sword",
+		VirtualCurrency: "GO",
+		Price:           5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Items) != 1 || res.Items[0].ItemId != "sword" {
+		t.Errorf("got items %+v, want the granted sword", res.Items)
+	}
+	if balance := srv.VirtualCurrency("player")["GO"]; balance != 5 {
+		t.Errorf("balance is %d, want 5: the player got the sword", balance)
+	}
+}
+
+func TestPurchaseUnknownGrantToOwnedStack(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	ownGems(srv, 5)
+	pf := newLostGrantClient(t, srv)
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "gem",
+		VirtualCurrency: "GO",
+		Price:           1,
+	})
+	var pErr *playfab.PurchaseError
+	if !errors.As(err, &pErr) || !pErr.Unknown || pErr.PurchaseId == "" {
+		t.Fatalf("got %v, want a PurchaseError with an unknown outcome", err)
+	}
+	if balance := srv.VirtualCurrency("player")["GO"]; balance != 9 {
+		t.Errorf("balance is %d, want 9: nothing may be refunded", balance)
+	}
+	if uses := gemUses(t, srv); uses != 6 {
+		t.Errorf("gem uses are %d, want 6", uses)
+	}
+}
+
+// spendBeforeDebit sets the player's GO balance right before the debit
+// reaches the server, as a concurrent purchase would.
+type spendBeforeDebit struct {
+	base    http.RoundTripper
+	srv     *playfabtest.Server
+	balance int32
+}
+
+func (t spendBeforeDebit) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.HasSuffix(req.URL.Path, "/SubtractUserVirtualCurrency") {
+		t.srv.SetVirtualCurrency("player", "GO", t.balance)
+	}
+	return t.base.RoundTrip(req)
+}
+
+func TestPurchaseInsufficientFunds(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	srv.SetVirtualCurrency("player", "GO", 3)
+	pf, _ := srv.NewClient("main")
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "sword",
+		VirtualCurrency: "GO",
+		Price:           5,
+	})
+	if !errors.Is(err, playfab.ErrInsufficientFunds) {
+		t.Fatalf("got %v, want ErrInsufficientFunds", err)
+	}
+	if srv.Calls("SubtractUserVirtualCurrency") != 0 || srv.Calls("GrantItemsToUser") != 0 {
+		t.Error("player was debited or granted the item")
+	}
+	if balance := srv.VirtualCurrency("player")["GO"]; balance != 3 {
+		t.Errorf("balance is %d, want 3", balance)
+	}
+}
+
+func TestPurchaseRefundsNegativeBalance(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main", playfab.WithHTTPClient(&http.Client{
+		Transport: spendBeforeDebit{base: srv.Client().Transport, srv: srv, balance: 2},
+	}))
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "sword",
+		VirtualCurrency: "GO",
+		Price:           5,
+	})
+	var pErr *playfab.PurchaseError
+	if !errors.As(err, &pErr) || pErr.CompensationErr != nil || !errors.Is(err, playfab.ErrInsufficientFunds) {
+		t.Fatalf("got %v, want a rolled back ErrInsufficientFunds", err)
+	}
+	if srv.Calls("GrantItemsToUser") != 0 {
+		t.Error("the item was granted")
+	}
+	if balance := srv.VirtualCurrency("player")["GO"]; balance != 2 {
+		t.Errorf("balance is %d, want the refunded 2", balance)
+	}
+}
+
+func TestPurchaseUnknownDebit(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	srv.FailNext("SubtractUserVirtualCurrency", playfabtest.ServiceUnavailable)
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "sword",
+		VirtualCurrency: "GO",
+		Price:           5,
+	})
+	var pErr *playfab.PurchaseError
+	if !errors.As(err, &pErr) || !pErr.Unknown || pErr.PurchaseId == "" {
+		t.Fatalf("got %v, want a PurchaseError with an unknown outcome", err)
+	}
+	if srv.Calls("GrantItemsToUser") != 0 || srv.Calls("AddUserVirtualCurrency") != 0 {
+		t.Error("the item was granted or the debit refunded")
+	}
+}
+
+func TestPurchaseRejectedDebit(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	srv.FailNext("SubtractUserVirtualCurrency", playfabtest.Failure{ErrorCode: playfab.ErrInvalidParams})
+	pf, _ := srv.NewClient("main")
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "sword",
+		VirtualCurrency: "GO",
+		Price:           5,
+	})
+	var pErr *playfab.PurchaseError
+	if errors.As(err, &pErr) || !errors.Is(err, playfab.ErrInvalidParams) {
+		t.Fatalf("got %v, want the debit's error", err)
+	}
+	if srv.Calls("GrantItemsToUser") != 0 || srv.Calls("AddUserVirtualCurrency") != 0 {
+		t.Error("the item was granted or the debit refunded")
+	}
+}
+
+type fixedPrice uint32
+
+func (p fixedPrice) Price(itemId string, currency string) (uint32, bool) {
+	return uint32(p), true
+}
+
+func TestPurchasePriceTooHigh(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+
+	_, err := pf.Purchase(context.Background(), fixedPrice(math.MaxInt32+1), &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "sword",
+		VirtualCurrency: "GO",
+		Price:           math.MaxInt32 + 1,
+	})
+	if !errors.Is(err, playfab.ErrInvalidParams) {
+		t.Fatalf("got %v, want ErrInvalidParams", err)
+	}
+	if srv.Calls("SubtractUserVirtualCurrency") != 0 {
+		t.Error("player was debited")
+	}
+}
+
+func TestPurchaseFailedGrantRevokesNothing(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+
+	// The item is priced but missing from the catalog, so its grant fails.
+	_, err := pf.Purchase(context.Background(), fixedPrice(4), &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "missing",
+		VirtualCurrency: "GO",
+		Price:           4,
+	})
+	var pErr *playfab.PurchaseError
+	if !errors.As(err, &pErr) || pErr.CompensationErr != nil {
+		t.Fatalf("got %v, want a rolled back PurchaseError", err)
+	}
+	if n := srv.Calls("RevokeInventoryItems"); n != 0 {
+		t.Errorf("RevokeInventoryItems called %d times with nothing to revoke", n)
+	}
+	if balance := srv.VirtualCurrency("player")["GO"]; balance != 10 {
+		t.Errorf("balance is %d, want the refunded 10", balance)
+	}
+}
+
+func TestPurchaseRefundsWhenRevokeFails(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	srv.FailNext("RevokeInventoryItems", playfabtest.Failure{ErrorCode: playfab.ErrInvalidParams})
+	pf, _ := srv.NewClient("main")
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "pack",
+		VirtualCurrency: "GO",
+		Price:           3,
+	})
+	var pErr *playfab.PurchaseError
+	if !errors.As(err, &pErr) || pErr.CompensationErr == nil {
+		t.Fatalf("got %v, want a PurchaseError with a failed rollback", err)
+	}
+	if gemUses(t, srv) == 0 {
+		t.Error("the granted gem was revoked")
+	}
+	if balance := srv.VirtualCurrency("player")["GO"]; balance != 10 {
+		t.Errorf("balance is %d, want the refunded 10", balance)
+	}
+}
+
+func TestPurchaseRefundsWhenModifyUsesFails(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	ownGems(srv, 5)
+	srv.FailNext("ModifyItemUses", playfabtest.Failure{ErrorCode: playfab.ErrInvalidParams})
+	srv.FailNext("AddUserVirtualCurrency", playfabtest.Failure{ErrorCode: playfab.ErrInvalidParams})
+	pf, _ := srv.NewClient("main")
+
+	_, err := pf.Purchase(context.Background(), nil, &playfab.PurchaseRequest{
+		PlayFabId:       "player",
+		ItemId:          "pack",
+		VirtualCurrency: "GO",
+		Price:           3,
+	})
+	var pErr *playfab.PurchaseError
+	if !errors.As(err, &pErr) || pErr.CompensationErr == nil {
+		t.Fatalf("got %v, want a PurchaseError with a failed rollback", err)
+	}
+	// Both failures are reported, and the refund was attempted after the
+	// first one.
+	msg := pErr.CompensationErr.Error()
+	if !strings.Contains(msg, "uses") || !strings.Contains(msg, "refund") {
+		t.Errorf("CompensationErr %q does not report both failures", msg)
+	}
+	if n := srv.Calls("AddUserVirtualCurrency"); n != 1 {
+		t.Errorf("refund was attempted %d times, want 1", n)
+	}
+}
+
+func TestSessionPurchaseItem(t *testing.T) {
+	srv := newPurchaseServer()
+	defer srv.Close()
+	srv.SetStore("main", "sale", playfabtest.Store{Items: []playfab.StoreItem{
+		{ItemId: "sword", VirtualCurrencyPrices: map[string]uint32{"GO": 2}},
+	}})
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+	s, err := pf.Client().LoginWithCustomID(ctx, &playfab.LoginWithCustomIDRequest{CustomId: "bot-1", CreateAccount: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	playFabId := s.LoginResult().PlayFabId
+	srv.SetVirtualCurrency(playFabId, "GO", 10)
+
+	res, err := s.PurchaseItem(ctx, &playfab.PurchaseItemRequest{ItemId: "sword", VirtualCurrency: "GO", Price: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Items) != 1 || res.Items[0].ItemId != "sword" {
+		t.Errorf("got %+v", res)
+	}
+	res, err = s.PurchaseItem(ctx, &playfab.PurchaseItemRequest{ItemId: "sword", VirtualCurrency: "GO", Price: 2, StoreId: "sale"})
+	if err != nil || len(res.Items) != 1 {
+		t.Fatalf("got %+v, %v", res, err)
+	}
+	if balance := srv.VirtualCurrency(playFabId)["GO"]; balance != 3 {
+		t.Errorf("balance is %d, want 3", balance)
+	}
+	if v, _ := sentCatalogVersion(t, srv, "PurchaseItem"); v != "main" {
+		t.Errorf("sent CatalogVersion %q, want the client default", v)
+	}
+
+	_, err = s.PurchaseItem(ctx, &playfab.PurchaseItemRequest{ItemId: "sword", VirtualCurrency: "GO", Price: 4})
+	if !errors.Is(err, playfab.ErrWrongPrice) {
+		t.Errorf("got %v, want ErrWrongPrice", err)
+	}
+	_, err = s.PurchaseItem(ctx, &playfab.PurchaseItemRequest{ItemId: "sword", VirtualCurrency: "GO", Price: 5})
+	if !errors.Is(err, playfab.ErrInsufficientFunds) {
+		t.Errorf("got %v, want ErrInsufficientFunds", err)
+	}
+	if n := len(srv.Inventory(playFabId)); n != 2 {
+		t.Errorf("player holds %d items, want 2", n)
+	}
+}
@@ -0,0 +1,158 @@
+package playfab
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy decides whether and when a failed PlayFab call is attempted again.
+type RetryPolicy interface {
+	// MaxAttempts is the total number of attempts made for a call, including the first one.
+	MaxAttempts() int
+	// Retryable reports whether err, returned by the PlayFab function funcName, may be retried.
+	Retryable(funcName string, err error) bool
+	// Backoff returns how long to wait before the given retry, starting at 1.
+	Backoff(retry int, err error) time.Duration
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(pf *PlayFab) {
+		pf.retry = policy
+	}
+}
+
+// nonIdempotent lists the PlayFab functions that change state in a way a
+// repeated call would duplicate. They are only retried when a policy opts in.
+var nonIdempotent = map[string]bool{
+	"AddUserVirtualCurrency":      true,
+	"SubtractUserVirtualCurrency": true,
+	"GrantItemsToUser":            true,
+	"ConsumeItem":                 true,
+	"RevokeInventoryItems":        true,
+	"ModifyItemUses":              true,
+	"GrantItemsToUsers":           true,
+	"UnlockContainerInstance":     true,
+	"UnlockContainerItem":         true,
+	"RedeemCoupon":                true,
+	"SendPushNotification":        true,
+
+	"IncrementPlayerStatisticVersion": true,
+	"RegisterPlayFabUser":             true,
+	"PurchaseItem":                    true,
+}
+
+// ExponentialBackoff is a RetryPolicy that doubles the delay after every
+// attempt, randomizes it with jitter and honors the Retry-After hint PlayFab
+// sends with throttling errors.
+type ExponentialBackoff struct {
+	// Attempts is the total number of attempts, including the first one.
+	Attempts int
+	// BaseDelay is the delay before the first retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed delay. A Retry-After hint may exceed it.
+	MaxDelay time.Duration
+	// Jitter is the fraction of each delay, between 0 and 1, that is randomized.
+	Jitter float64
+	// Classifier decides which errors are retryable. IsRetryable is used when nil.
+	Classifier func(err error) bool
+	// RetryNonIdempotent opts non-idempotent functions, such as
+	// "AddUserVirtualCurrency", in to retries.
+	RetryNonIdempotent []string
+}
+
+func DefaultRetryPolicy() *ExponentialBackoff {
+	return &ExponentialBackoff{
+		Attempts:  4,
+		BaseDelay: 500 * time.Millisecond,
+		MaxDelay:  8 * time.Second,
+		Jitter:    0.5,
+	}
+}
+
+func NoRetry() *ExponentialBackoff {
+	return &ExponentialBackoff{Attempts: 1}
+}
+
+func (b *ExponentialBackoff) MaxAttempts() int {
+	return b.Attempts
+}
+
+func (b *ExponentialBackoff) Retryable(funcName string, err error) bool {
+	if nonIdempotent[funcName] && !b.optedIn(funcName) {
+		return false
+	}
+	if b.Classifier != nil {
+		return b.Classifier(err)
+	}
+	return IsRetryable(err)
+}
+
+func (b *ExponentialBackoff) Backoff(retry int, err error) time.Duration {
+	delay := b.BaseDelay
+	for i := 1; i < retry && (b.MaxDelay <= 0 || delay < b.MaxDelay); i++ {
+		delay *= 2
+	}
+	if b.MaxDelay > 0 && delay > b.MaxDelay {
+		delay = b.MaxDelay
+	}
+	if b.Jitter > 0 {
+		delay -= time.Duration(rand.Float64() * b.Jitter * float64(delay))
+	}
+	var pfErr *PlayFabError
+	if errors.As(err, &pfErr) && pfErr.RetryAfter > delay {
+		delay = pfErr.RetryAfter
+	}
+	return delay
+}
+
+func (b *ExponentialBackoff) optedIn(funcName string) bool {
+	for _, name := range b.RetryNonIdempotent {
+		if name == funcName {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRetryable is the default retry classifier. Transport failures, conflicts,
+// throttling and gateway errors are retryable; anything PlayFab rejected as
+// invalid, including 400 Bad Request, is not.
+func IsRetryable(err error) bool {
+	var pfErr *PlayFabError
+	if !errors.As(err, &pfErr) {
+		return err != nil
+	}
+	if IsErrorCode(err, ErrServiceUnavailable, ErrAPIRequestLimitExceeded, ErrConcurrentEditError, ErrDataUpdateRateExceeded) {
+		return true
+	}
+	switch pfErr.RespCode {
+	case http.StatusConflict,
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	case 0:
+		// No response was received, the request failed in transport.
+		return pfErr.ErrorCode == ErrUnknown
+	}
+	return false
+}
+
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(header); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
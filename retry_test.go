@@ -0,0 +1,112 @@
+package playfab_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+// fastRetry retries like the default policy without making tests wait.
+func fastRetry() *playfab.ExponentialBackoff {
+	return &playfab.ExponentialBackoff{Attempts: 4, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+}
+
+func TestRetryRetryableFailures(t *testing.T) {
+	for _, f := range []playfabtest.Failure{playfabtest.ServiceUnavailable, playfabtest.Conflict} {
+		srv := playfabtest.NewServer()
+		srv.AddPlayer("player")
+		srv.FailNext("GetUserInventory", f, f)
+		pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(fastRetry()))
+
+		if _, err := pf.GetUserInventoryTyped(context.Background(), &playfab.GetUserInventoryRequest{PlayFabId: "player"}); err != nil {
+			t.Errorf("%d: %v", f.HTTPStatus, err)
+		}
+		if n := srv.Calls("GetUserInventory"); n != 3 {
+			t.Errorf("%d: got %d calls, want 3", f.HTTPStatus, n)
+		}
+		srv.Close()
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddPlayer("player")
+	f := playfabtest.ServiceUnavailable
+	srv.FailNext("GetUserInventory", f, f, f, f, f)
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(fastRetry()))
+
+	if _, err := pf.GetUserInventoryTyped(context.Background(), &playfab.GetUserInventoryRequest{PlayFabId: "player"}); err == nil {
+		t.Error("got no error")
+	}
+	if n := srv.Calls("GetUserInventory"); n != 4 {
+		t.Errorf("got %d calls, want 4", n)
+	}
+}
+
+func TestRetryBadRequestIsNotRetried(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddPlayer("player")
+	srv.FailNext("GetUserInventory", playfabtest.Failure{ErrorCode: playfab.ErrInvalidParams})
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(fastRetry()))
+
+	if _, err := pf.GetUserInventoryTyped(context.Background(), &playfab.GetUserInventoryRequest{PlayFabId: "player"}); err == nil {
+		t.Error("got no error")
+	}
+	if n := srv.Calls("GetUserInventory"); n != 1 {
+		t.Errorf("got %d calls, want 1", n)
+	}
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	add := &playfab.AddUserVirtualCurrencyRequest{PlayFabId: "player", VirtualCurrency: "GO", Amount: 5}
+
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetVirtualCurrency("player", "GO", 0)
+	srv.FailNext("AddUserVirtualCurrency", playfabtest.ServiceUnavailable)
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(fastRetry()))
+	if _, err := pf.AddUserVirtualCurrencyTyped(context.Background(), add); err == nil {
+		t.Error("got no error without opting in")
+	}
+	if n := srv.Calls("AddUserVirtualCurrency"); n != 1 {
+		t.Errorf("got %d calls without opting in, want 1", n)
+	}
+
+	policy := fastRetry()
+	policy.RetryNonIdempotent = []string{"AddUserVirtualCurrency"}
+	srv.FailNext("AddUserVirtualCurrency", playfabtest.ServiceUnavailable)
+	pf, _ = srv.NewClient("main", playfab.WithRetryPolicy(policy))
+	res, err := pf.AddUserVirtualCurrencyTyped(context.Background(), add)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Balance != 5 {
+		t.Errorf("balance is %d, want 5", res.Balance)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	b := &playfab.ExponentialBackoff{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+	for retry, want := range []time.Duration{100, 200, 300, 300} {
+		if got := b.Backoff(retry+1, nil); got != want*time.Millisecond {
+			t.Errorf("retry %d: got %s, want %s", retry+1, got, want*time.Millisecond)
+		}
+	}
+
+	b.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := b.Backoff(2, nil); got < 100*time.Millisecond || got > 200*time.Millisecond {
+			t.Fatalf("jittered delay %s is out of [100ms, 200ms]", got)
+		}
+	}
+
+	throttled := &playfab.PlayFabError{RetryAfter: 2 * time.Second}
+	if got := b.Backoff(1, throttled); got != 2*time.Second {
+		t.Errorf("got %s, want the 2s Retry-After", got)
+	}
+}
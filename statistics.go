@@ -0,0 +1,96 @@
+package playfab
+
+import (
+	"context"
+	"time"
+)
+
+type StatisticResetIntervalOption string
+
+const (
+	StatisticResetNever StatisticResetIntervalOption = "Never"
+	StatisticResetHour  StatisticResetIntervalOption = "Hour"
+	StatisticResetDay   StatisticResetIntervalOption = "Day"
+	StatisticResetWeek  StatisticResetIntervalOption = "Week"
+	StatisticResetMonth StatisticResetIntervalOption = "Month"
+)
+
+type StatisticAggregationMethod string
+
+const (
+	StatisticAggregationLast StatisticAggregationMethod = "Last"
+	StatisticAggregationMin  StatisticAggregationMethod = "Min"
+	StatisticAggregationMax  StatisticAggregationMethod = "Max"
+	StatisticAggregationSum  StatisticAggregationMethod = "Sum"
+)
+
+type PlayerStatisticVersion struct {
+	StatisticName             string
+	Version                   uint32
+	ActivationTime            *time.Time `json:",omitempty"`
+	DeactivationTime          *time.Time `json:",omitempty"`
+	ScheduledActivationTime   *time.Time `json:",omitempty"`
+	ScheduledDeactivationTime *time.Time `json:",omitempty"`
+}
+
+type PlayerStatisticDefinition struct {
+	StatisticName         string
+	CurrentVersion        uint32
+	VersionChangeInterval StatisticResetIntervalOption `json:",omitempty"`
+	AggregationMethod     StatisticAggregationMethod   `json:",omitempty"`
+}
+
+type GetPlayerStatisticVersionsRequest struct {
+	StatisticName string
+}
+
+type GetPlayerStatisticVersionsResult struct {
+	StatisticVersions []PlayerStatisticVersion
+}
+
+type CreatePlayerStatisticDefinitionRequest struct {
+	StatisticName         string
+	VersionChangeInterval StatisticResetIntervalOption `json:",omitempty"`
+	AggregationMethod     StatisticAggregationMethod   `json:",omitempty"`
+}
+
+type CreatePlayerStatisticDefinitionResult struct {
+	Statistic *PlayerStatisticDefinition
+}
+
+type IncrementPlayerStatisticVersionRequest struct {
+	StatisticName string
+}
+
+type IncrementPlayerStatisticVersionResult struct {
+	StatisticVersion *PlayerStatisticVersion
+}
+
+// StatisticVersion returns a pointer to v, for use as StatisticUpdate.Version.
+func StatisticVersion(v uint32) *uint32 {
+	return &v
+}
+
+func (pf *PlayFab) GetPlayerStatisticVersions(ctx context.Context, req *GetPlayerStatisticVersionsRequest) (*GetPlayerStatisticVersionsResult, error) {
+	res := &GetPlayerStatisticVersionsResult{}
+	if err := pf.call(ctx, "Server", "GetPlayerStatisticVersions", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (a *Admin) CreatePlayerStatisticDefinition(ctx context.Context, req *CreatePlayerStatisticDefinitionRequest) (*CreatePlayerStatisticDefinitionResult, error) {
+	res := &CreatePlayerStatisticDefinitionResult{}
+	if err := a.pf.call(ctx, "Admin", "CreatePlayerStatisticDefinition", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (a *Admin) IncrementPlayerStatisticVersion(ctx context.Context, req *IncrementPlayerStatisticVersionRequest) (*IncrementPlayerStatisticVersionResult, error) {
+	res := &IncrementPlayerStatisticVersionResult{}
+	if err := a.pf.call(ctx, "Admin", "IncrementPlayerStatisticVersion", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,51 @@
+package playfab
+
+import "context"
+
+// Store is a store with everything GetStoreItems returns about it. Prices of
+// stores read for a player include the player's segment overrides.
+type Store struct {
+	StoreId        string
+	CatalogVersion string
+	Items          []StoreItem
+	MarketingData  *StoreMarketingModel
+	Source         string
+	// PlayFabId is the player the store was read for, if any.
+	PlayFabId string
+}
+
+// GetFullStore reads a store with its items and marketing data in one call.
+// With req.PlayFabId set the prices are those the player sees.
+func (pf *PlayFab) GetFullStore(ctx context.Context, req *GetStoreItemsRequest) (*Store, error) {
+	res, err := pf.GetStoreItemsTyped(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{
+		StoreId:        res.StoreId,
+		CatalogVersion: res.CatalogVersion,
+		Items:          res.Store,
+		MarketingData:  res.MarketingData,
+		Source:         res.Source,
+		PlayFabId:      req.PlayFabId,
+	}, nil
+}
+
+func (s *Store) Item(itemId string) (*StoreItem, bool) {
+	for i := range s.Items {
+		if s.Items[i].ItemId == itemId {
+			return &s.Items[i], true
+		}
+	}
+	return nil, false
+}
+
+// Price returns the virtual currency price of itemId in the store.
+func (s *Store) Price(itemId string, currency string) (uint32, bool) {
+	item, ok := s.Item(itemId)
+	if !ok {
+		return 0, false
+	}
+	price, ok := item.VirtualCurrencyPrices[currency]
+	return price, ok
+}
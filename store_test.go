@@ -0,0 +1,121 @@
+package playfab_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func newStoreServer() *playfabtest.Server {
+	srv := playfabtest.NewServer()
+	srv.SetCatalog("main", playfab.CatalogItem{ItemId: "sword"}, playfab.CatalogItem{ItemId: "shield"})
+	srv.SetStore("main", "shop", playfabtest.Store{
+		Items: []playfab.StoreItem{
+			{
+				ItemId:                "sword",
+				VirtualCurrencyPrices: map[string]uint32{"GO": 100, "GE": 5},
+				RealCurrencyPrices:    map[string]uint32{"RM": 199},
+				CustomData:            map[string]interface{}{"badge": "new"},
+			},
+			{ItemId: "shield", VirtualCurrencyPrices: map[string]uint32{"GO": 50}},
+		},
+		MarketingData: &playfab.StoreMarketingModel{
+			DisplayName: "Shop",
+			Description: "Everyday items",
+			Metadata:    map[string]interface{}{"banner": "shop.png"},
+		},
+	})
+	srv.AddPlayer("player")
+	return srv
+}
+
+func TestGetFullStore(t *testing.T) {
+	srv := newStoreServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+
+	store, err := pf.GetFullStore(context.Background(), &playfab.GetStoreItemsRequest{StoreId: "shop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.StoreId != "shop" || store.CatalogVersion != "main" || store.Source != "Admin" || store.PlayFabId != "" {
+		t.Errorf("got %+v", store)
+	}
+	if len(store.Items) != 2 {
+		t.Fatalf("got items %+v", store.Items)
+	}
+	m := store.MarketingData
+	if m == nil || m.DisplayName != "Shop" || m.Description != "Everyday items" {
+		t.Fatalf("got marketing data %+v", m)
+	}
+	if meta, _ := m.Metadata.(map[string]interface{}); meta["banner"] != "shop.png" {
+		t.Errorf("got metadata %v", m.Metadata)
+	}
+
+	sword, ok := store.Item("sword")
+	if !ok {
+		t.Fatal("sword not found")
+	}
+	if sword.RealCurrencyPrices["RM"] != 199 {
+		t.Errorf("got real prices %v", sword.RealCurrencyPrices)
+	}
+	if data, _ := sword.CustomData.(map[string]interface{}); data["badge"] != "new" {
+		t.Errorf("got custom data %v", sword.CustomData)
+	}
+	if _, ok := store.Item("bow"); ok {
+		t.Error("found an item that is not in the store")
+	}
+
+	if n := srv.Calls("GetStoreItems"); n != 1 {
+		t.Errorf("GetStoreItems called %d times, want 1", n)
+	}
+}
+
+func TestGetFullStoreForPlayer(t *testing.T) {
+	srv := newStoreServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	store, err := pf.GetFullStore(ctx, &playfab.GetStoreItemsRequest{StoreId: "shop", PlayFabId: "player"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.PlayFabId != "player" || len(store.Items) != 2 {
+		t.Errorf("got %+v", store)
+	}
+
+	if _, err := pf.GetFullStore(ctx, &playfab.GetStoreItemsRequest{StoreId: "shop", PlayFabId: "nobody"}); !playfab.IsErrorCode(err, playfab.ErrAccountNotFound) {
+		t.Errorf("got %v, want AccountNotFound", err)
+	}
+	if _, err := pf.GetFullStore(ctx, &playfab.GetStoreItemsRequest{StoreId: "nope"}); !playfab.IsErrorCode(err, playfab.ErrStoreNotFound) {
+		t.Errorf("got %v, want StoreNotFound", err)
+	}
+}
+
+func TestStorePrice(t *testing.T) {
+	store := &playfab.Store{Items: []playfab.StoreItem{
+		{ItemId: "sword", VirtualCurrencyPrices: map[string]uint32{"GO": 100, "GE": 5}},
+		{ItemId: "shield"},
+	}}
+
+	tests := []struct {
+		itemId, currency string
+		price            uint32
+		ok               bool
+	}{
+		{"sword", "GO", 100, true},
+		{"sword", "GE", 5, true},
+		{"sword", "XX", 0, false},
+		{"shield", "GO", 0, false},
+		{"bow", "GO", 0, false},
+	}
+	for _, tt := range tests {
+		price, ok := store.Price(tt.itemId, tt.currency)
+		if price != tt.price || ok != tt.ok {
+			t.Errorf("Price(%s, %s) = %d, %v, want %d, %v", tt.itemId, tt.currency, price, ok, tt.price, tt.ok)
+		}
+	}
+}
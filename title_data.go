@@ -0,0 +1,143 @@
+package playfab
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type SetPublisherDataRequest struct {
+	Key   string
+	Value string `json:",omitempty"`
+}
+
+type GetPublisherDataRequest struct {
+	Keys []string
+}
+
+type GetPublisherDataResult struct {
+	Data map[string]string
+}
+
+type TitleDataKeyValue struct {
+	Key   string
+	Value string `json:",omitempty"`
+}
+
+type SetTitleDataAndOverridesRequest struct {
+	OverrideLabel string `json:",omitempty"`
+	KeyValues     []TitleDataKeyValue
+}
+
+// SetTitleData sets a title data key. An empty Value deletes the key.
+func (pf *PlayFab) SetTitleData(ctx context.Context, req *SetTitleDataRequest) error {
+	return pf.call(ctx, "Server", "SetTitleData", req, nil)
+}
+
+func (pf *PlayFab) SetTitleInternalData(ctx context.Context, req *SetTitleDataRequest) error {
+	return pf.call(ctx, "Server", "SetTitleInternalData", req, nil)
+}
+
+// SetPublisherData sets a key shared by all titles of the publisher. An
+// empty Value deletes the key.
+func (pf *PlayFab) SetPublisherData(ctx context.Context, req *SetPublisherDataRequest) error {
+	return pf.call(ctx, "Server", "SetPublisherData", req, nil)
+}
+
+func (pf *PlayFab) GetPublisherData(ctx context.Context, req *GetPublisherDataRequest) (*GetPublisherDataResult, error) {
+	res := &GetPublisherDataResult{}
+	if err := pf.call(ctx, "Server", "GetPublisherData", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetTitleDataValue stores value as JSON under key.
+func (pf *PlayFab) SetTitleDataValue(ctx context.Context, key string, value interface{}) error {
+	v, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return pf.SetTitleData(ctx, &SetTitleDataRequest{Key: key, Value: string(v)})
+}
+
+// GetTitleDataValue decodes the JSON stored under key into out. With an
+// overrideLabel the value of that override is read when it has one. It
+// reports false, leaving out untouched, when the key is not set.
+func (pf *PlayFab) GetTitleDataValue(ctx context.Context, key string, overrideLabel string, out interface{}) (bool, error) {
+	res, err := pf.GetTitleDataTyped(ctx, &GetTitleDataRequest{Keys: []string{key}, OverrideLabel: overrideLabel})
+	if err != nil {
+		return false, err
+	}
+	return decodeValue(res.Data, key, out)
+}
+
+// SetTitleInternalDataValue stores value as JSON under key in the title
+// internal data.
+func (pf *PlayFab) SetTitleInternalDataValue(ctx context.Context, key string, value interface{}) error {
+	v, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return pf.SetTitleInternalData(ctx, &SetTitleDataRequest{Key: key, Value: string(v)})
+}
+
+// GetTitleInternalDataValue decodes the JSON stored under key in the title
+// internal data into out. It reports false, leaving out untouched, when the
+// key is not set.
+func (pf *PlayFab) GetTitleInternalDataValue(ctx context.Context, key string, out interface{}) (bool, error) {
+	res, err := pf.GetTitleInternalDataTyped(ctx, &GetTitleDataRequest{Keys: []string{key}})
+	if err != nil {
+		return false, err
+	}
+	return decodeValue(res.Data, key, out)
+}
+
+// SetPublisherDataValue stores value as JSON under key.
+func (pf *PlayFab) SetPublisherDataValue(ctx context.Context, key string, value interface{}) error {
+	v, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return pf.SetPublisherData(ctx, &SetPublisherDataRequest{Key: key, Value: string(v)})
+}
+
+// GetPublisherDataValue decodes the JSON stored under key into out. It
+// reports false, leaving out untouched, when the key is not set.
+func (pf *PlayFab) GetPublisherDataValue(ctx context.Context, key string, out interface{}) (bool, error) {
+	res, err := pf.GetPublisherData(ctx, &GetPublisherDataRequest{Keys: []string{key}})
+	if err != nil {
+		return false, err
+	}
+	return decodeValue(res.Data, key, out)
+}
+
+// SetTitleDataAndOverrides sets title data keys, in the override named by
+// OverrideLabel when it is not empty.
+func (a *Admin) SetTitleDataAndOverrides(ctx context.Context, req *SetTitleDataAndOverridesRequest) error {
+	return a.pf.call(ctx, "Admin", "SetTitleDataAndOverrides", req, nil)
+}
+
+// SetTitleDataOverrideValue stores value as JSON under key in the title data
+// override named overrideLabel, or in the title data itself when the label is
+// empty. The Server API cannot write overrides, so this goes through Admin.
+func (a *Admin) SetTitleDataOverrideValue(ctx context.Context, overrideLabel string, key string, value interface{}) error {
+	v, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return a.SetTitleDataAndOverrides(ctx, &SetTitleDataAndOverridesRequest{
+		OverrideLabel: overrideLabel,
+		KeyValues:     []TitleDataKeyValue{{Key: key, Value: string(v)}},
+	})
+}
+
+func decodeValue(data map[string]string, key string, out interface{}) (bool, error) {
+	v, ok := data[key]
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal([]byte(v), out); err != nil {
+		return true, err
+	}
+	return true, nil
+}
@@ -0,0 +1,198 @@
+package playfab
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTitleDataTTL = time.Minute
+	// titleDataFetchTimeout bounds a background fetch, retries included,
+	// so a hung request does not hold up the misses of its key forever.
+	titleDataFetchTimeout = 30 * time.Second
+)
+
+type TitleDataCacheOption func(c *TitleDataCache)
+
+// WithTitleDataTTL sets how long a cached key is fresh. It defaults to one
+// minute.
+func WithTitleDataTTL(ttl time.Duration) TitleDataCacheOption {
+	return func(c *TitleDataCache) {
+		c.ttl = ttl
+	}
+}
+
+// WithTitleDataKeyTTL overrides the TTL of a single key.
+func WithTitleDataKeyTTL(key string, ttl time.Duration) TitleDataCacheOption {
+	return func(c *TitleDataCache) {
+		c.keyTTL[key] = ttl
+	}
+}
+
+// WithTitleInternalData makes the cache read title internal data instead of
+// title data.
+func WithTitleInternalData() TitleDataCacheOption {
+	return func(c *TitleDataCache) {
+		c.funcName = "GetTitleInternalData"
+	}
+}
+
+// WithTitleDataOverrideLabel reads the values of an override label.
+func WithTitleDataOverrideLabel(label string) TitleDataCacheOption {
+	return func(c *TitleDataCache) {
+		c.overrideLabel = label
+	}
+}
+
+// TitleDataCache caches title data keys. A fresh key is served from memory.
+// A stale key is served from memory while it is fetched again in the
+// background, and keeps being served while PlayFab can't be reached. Only
+// missing keys wait for PlayFab, and concurrent misses of a key share one
+// request.
+type TitleDataCache struct {
+	pf            *PlayFab
+	funcName      string
+	overrideLabel string
+	ttl           time.Duration
+	keyTTL        map[string]time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*titleDataEntry
+	flights map[string]*titleDataFlight
+}
+
+type titleDataEntry struct {
+	value   string
+	found   bool
+	fetched time.Time
+}
+
+type titleDataFlight struct {
+	done  chan struct{}
+	entry *titleDataEntry
+	err   error
+}
+
+func (pf *PlayFab) NewTitleDataCache(opts ...TitleDataCacheOption) *TitleDataCache {
+	c := &TitleDataCache{
+		pf:       pf,
+		funcName: "GetTitleData",
+		ttl:      defaultTitleDataTTL,
+		keyTTL:   make(map[string]time.Duration),
+		entries:  make(map[string]*titleDataEntry),
+		flights:  make(map[string]*titleDataFlight),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Get returns the value of key and whether it is set.
+func (c *TitleDataCache) Get(ctx context.Context, key string) (string, bool, error) {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if ok {
+		if time.Since(e.fetched) >= c.ttlOf(key) {
+			c.fetch(key)
+		}
+		c.mu.Unlock()
+		return e.value, e.found, nil
+	}
+	f := c.fetch(key)
+	c.mu.Unlock()
+
+	select {
+	case <-f.done:
+	case <-ctx.Done():
+		return "", false, ctx.Err()
+	}
+	if f.err != nil {
+		return "", false, f.err
+	}
+	return f.entry.value, f.entry.found, nil
+}
+
+// GetValue decodes the JSON stored under key into out. It reports false,
+// leaving out untouched, when the key is not set.
+func (c *TitleDataCache) GetValue(ctx context.Context, key string, out interface{}) (bool, error) {
+	v, found, err := c.Get(ctx, key)
+	if err != nil || !found {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(v), out); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// Invalidate drops the given keys, or every key when none are given, so the
+// next Get waits for a fresh value.
+func (c *TitleDataCache) Invalidate(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(keys) == 0 {
+		c.entries = make(map[string]*titleDataEntry)
+		c.flights = make(map[string]*titleDataFlight)
+		return
+	}
+	for _, k := range keys {
+		delete(c.entries, k)
+		delete(c.flights, k)
+	}
+}
+
+func (c *TitleDataCache) ttlOf(key string) time.Duration {
+	if ttl, ok := c.keyTTL[key]; ok {
+		return ttl
+	}
+	return c.ttl
+}
+
+// fetch starts fetching key unless it is already being fetched. c.mu must be
+// held.
+func (c *TitleDataCache) fetch(key string) *titleDataFlight {
+	if f, ok := c.flights[key]; ok {
+		return f
+	}
+	f := &titleDataFlight{done: make(chan struct{})}
+	c.flights[key] = f
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), titleDataFetchTimeout)
+		defer cancel()
+		res := &GetTitleDataResult{}
+		err := c.pf.call(ctx, "Server", c.funcName, &GetTitleDataRequest{
+			Keys:          []string{key},
+			OverrideLabel: c.overrideLabel,
+		}, res)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		// Invalidate drops the flights of the keys it drops, so a flight
+		// that is no longer registered may have fetched an outdated value.
+		current := c.flights[key] == f
+		if current {
+			delete(c.flights, key)
+		}
+		if err != nil {
+			f.err = err
+			// Keep serving the stale value and try again after another TTL
+			// rather than on every Get while PlayFab is down.
+			if e, stale := c.entries[key]; stale && current {
+				c.pf.logger.Warn("Failed to refresh title data %s, serving the cached value: %v", key, err)
+				c.entries[key] = &titleDataEntry{value: e.value, found: e.found, fetched: time.Now()}
+			}
+		} else {
+			v, found := res.Data[key]
+			f.entry = &titleDataEntry{value: v, found: found, fetched: time.Now()}
+			if current {
+				c.entries[key] = f.entry
+			}
+		}
+		close(f.done)
+	}()
+	return f
+}
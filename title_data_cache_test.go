@@ -0,0 +1,157 @@
+package playfab_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+// waitFor polls cond until it holds or a second has passed.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestTitleDataCacheServesStaleOnError(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetTitleData(map[string]string{"motd": "hello"})
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+	c := pf.NewTitleDataCache(playfab.WithTitleDataTTL(20 * time.Millisecond))
+	ctx := context.Background()
+
+	if v, found, err := c.Get(ctx, "motd"); err != nil || !found || v != "hello" {
+		t.Fatalf("got %q, %v, %v", v, found, err)
+	}
+
+	srv.SetTitleData(map[string]string{"motd": "bye"})
+	srv.FailNext("GetTitleData", playfabtest.ServiceUnavailable)
+	time.Sleep(30 * time.Millisecond)
+
+	// The stale value is served while the refresh fails, and after it.
+	if v, _, err := c.Get(ctx, "motd"); err != nil || v != "hello" {
+		t.Fatalf("got %q, %v, want the stale value", v, err)
+	}
+	waitFor(t, func() bool { return srv.Calls("GetTitleData") == 2 })
+	if v, _, err := c.Get(ctx, "motd"); err != nil || v != "hello" {
+		t.Fatalf("got %q, %v, want the stale value", v, err)
+	}
+
+	// Once PlayFab is back the next refresh replaces it.
+	waitFor(t, func() bool {
+		v, _, _ := c.Get(ctx, "motd")
+		return v == "bye"
+	})
+}
+
+func TestTitleDataCacheMissReturnsError(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.FailNext("GetTitleData", playfabtest.ServiceUnavailable)
+	pf, _ := srv.NewClient("main", playfab.WithRetryPolicy(playfab.NoRetry()))
+	c := pf.NewTitleDataCache()
+
+	if _, _, err := c.Get(context.Background(), "motd"); err == nil {
+		t.Error("got no error without a cached value")
+	}
+}
+
+// gateTransport holds requests whose body contains hold until release is
+// closed, signalling held as each one arrives.
+type gateTransport struct {
+	next    http.RoundTripper
+	hold    string
+	held    chan struct{}
+	release chan struct{}
+}
+
+func newGateTransport(srv *playfabtest.Server, hold string) *gateTransport {
+	return &gateTransport{
+		next:    srv.Client().Transport,
+		hold:    hold,
+		held:    make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+}
+
+func (g *gateTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if bytes.Contains(body, []byte(g.hold)) {
+		g.held <- struct{}{}
+		<-g.release
+	}
+	return g.next.RoundTrip(r)
+}
+
+func TestTitleDataCacheInvalidateKeepsOtherFlights(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetTitleData(map[string]string{"a": "1", "b": "2"})
+	gate := newGateTransport(srv, `"b"`)
+	pf, _ := srv.NewClient("main", playfab.WithHTTPClient(&http.Client{Transport: gate}))
+	c := pf.NewTitleDataCache()
+	ctx := context.Background()
+
+	got := make(chan string)
+	go func() {
+		v, _, _ := c.Get(ctx, "b")
+		got <- v
+	}()
+	<-gate.held
+	c.Invalidate("a")
+	close(gate.release)
+	if v := <-got; v != "2" {
+		t.Fatalf("got %q, want 2", v)
+	}
+
+	// The fetched value of b was cached despite a being invalidated.
+	srv.SetTitleData(map[string]string{"b": "3"})
+	if v, _, err := c.Get(ctx, "b"); err != nil || v != "2" {
+		t.Errorf("got %q, %v, want the cached value", v, err)
+	}
+	if n := srv.Calls("GetTitleData"); n != 1 {
+		t.Errorf("GetTitleData called %d times, want 1", n)
+	}
+}
+
+func TestTitleDataCacheInvalidateDropsInFlightValue(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetTitleData(map[string]string{"b": "old"})
+	gate := newGateTransport(srv, `"b"`)
+	pf, _ := srv.NewClient("main", playfab.WithHTTPClient(&http.Client{Transport: gate}))
+	c := pf.NewTitleDataCache()
+	ctx := context.Background()
+
+	got := make(chan string)
+	go func() {
+		v, _, _ := c.Get(ctx, "b")
+		got <- v
+	}()
+	<-gate.held
+	c.Invalidate("b")
+	close(gate.release)
+	<-got
+
+	// The value fetched before the invalidation is not cached.
+	srv.SetTitleData(map[string]string{"b": "new"})
+	if v, _, err := c.Get(ctx, "b"); err != nil || v != "new" {
+		t.Errorf("got %q, %v, want new", v, err)
+	}
+}
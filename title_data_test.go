@@ -0,0 +1,77 @@
+package playfab_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+type flags struct {
+	NewShop bool
+	Limit   int
+}
+
+func TestTitleDataValues(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	if err := pf.SetTitleDataValue(ctx, "flags", flags{NewShop: true, Limit: 3}); err != nil {
+		t.Fatal(err)
+	}
+	var got flags
+	ok, err := pf.GetTitleDataValue(ctx, "flags", "", &got)
+	if err != nil || !ok {
+		t.Fatalf("got %v, %v", ok, err)
+	}
+	if !got.NewShop || got.Limit != 3 {
+		t.Errorf("got %+v", got)
+	}
+	if ok, err := pf.GetTitleDataValue(ctx, "missing", "", &got); ok || err != nil {
+		t.Errorf("got %v, %v for a missing key", ok, err)
+	}
+
+	if err := pf.SetTitleInternalDataValue(ctx, "limits", []int{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	if v := srv.TitleInternalData()["limits"]; v != "[1,2]" {
+		t.Errorf("stored %q, want [1,2]", v)
+	}
+
+	if err := pf.SetPublisherDataValue(ctx, "motd", "hello"); err != nil {
+		t.Fatal(err)
+	}
+	var motd string
+	if ok, err := pf.GetPublisherDataValue(ctx, "motd", &motd); !ok || err != nil || motd != "hello" {
+		t.Errorf("got %q, %v, %v", motd, ok, err)
+	}
+}
+
+func TestTitleDataOverrideValue(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+	if err := pf.SetTitleDataValue(ctx, "flags", flags{Limit: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := pf.Admin().SetTitleDataOverrideValue(ctx, "beta", "flags", flags{NewShop: true, Limit: 5}); err != nil {
+		t.Fatal(err)
+	}
+	var base, beta flags
+	if _, err := pf.GetTitleDataValue(ctx, "flags", "", &base); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pf.GetTitleDataValue(ctx, "flags", "beta", &beta); err != nil {
+		t.Fatal(err)
+	}
+	if base.NewShop || base.Limit != 3 {
+		t.Errorf("base flags are %+v, want them untouched", base)
+	}
+	if !beta.NewShop || beta.Limit != 5 {
+		t.Errorf("beta flags are %+v, want the override", beta)
+	}
+}
@@ -0,0 +1,250 @@
+package playfab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// call sends req to a PlayFab function and decodes the data field of the
+// response into out. out may be nil when the result carries nothing useful.
+func (pf *PlayFab) call(ctx context.Context, api string, funcName string, req interface{}, out interface{}) error {
+	return pf.callAs(ctx, authHeader{"X-SecretKey", pf.secret}, api, funcName, req, out)
+}
+
+func (pf *PlayFab) callAs(ctx context.Context, auth authHeader, api string, funcName string, req interface{}, out interface{}) error {
+	requestBody, err := json.Marshal(req)
+
+	if err != nil {
+		return err
+	}
+
+	body, err := pf.requestAs(ctx, auth, "POST", api, funcName, requestBody)
+
+	if err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	res := struct {
+		Data json.RawMessage `json:"data"`
+	}{}
+	if err := json.Unmarshal(bytes.TrimPrefix(body, utf8BOM), &res); err != nil {
+		return err
+	}
+
+	if len(res.Data) == 0 {
+		return fmt.Errorf("Failed to parse %s result", funcName)
+	}
+
+	if err := json.Unmarshal(res.Data, out); err != nil {
+		return fmt.Errorf("Failed to parse %s result: %v", funcName, err)
+	}
+
+	return nil
+}
+
+func (pf *PlayFab) catalog(catalogVersion string) string {
+	if catalogVersion == "" {
+		return pf.catalogVersion
+	}
+	return catalogVersion
+}
+
+func (pf *PlayFab) EvaluateRandomTableTyped(ctx context.Context, req *EvaluateRandomResultTableRequest) (*EvaluateRandomResultTableResult, error) {
+	r := *req
+	r.CatalogVersion = pf.catalog(r.CatalogVersion)
+	res := &EvaluateRandomResultTableResult{}
+	if err := pf.call(ctx, "Server", "EvaluateRandomResultTable", &r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) UpdateUserInternalDataTyped(ctx context.Context, req *UpdateUserInternalDataRequest) (*UpdateUserDataResult, error) {
+	res := &UpdateUserDataResult{}
+	if err := pf.call(ctx, "Server", "UpdateUserInternalData", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetUserInternalDataTyped(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error) {
+	res := &GetUserDataResult{}
+	if err := pf.call(ctx, "Server", "GetUserInternalData", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) UpdateUserReadOnlyDataTyped(ctx context.Context, req *UpdateUserDataRequest) (*UpdateUserDataResult, error) {
+	res := &UpdateUserDataResult{}
+	if err := pf.call(ctx, "Server", "UpdateUserReadOnlyData", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetUserReadOnlyDataTyped(ctx context.Context, req *GetUserDataRequest) (*GetUserDataResult, error) {
+	res := &GetUserDataResult{}
+	if err := pf.call(ctx, "Server", "GetUserReadOnlyData", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GrantItemsToUserTyped(ctx context.Context, req *GrantItemsToUserRequest) (*GrantItemsToUserResult, error) {
+	r := *req
+	r.CatalogVersion = pf.catalog(r.CatalogVersion)
+	pf.logger.Debug("grant items to user playfabId: %s, itemIds %s", r.PlayFabId, r.ItemIds)
+	res := &GrantItemsToUserResult{}
+	if err := pf.call(ctx, "Server", "GrantItemsToUser", &r, res); err != nil {
+		pf.logger.Debug("Failed Grant Items To User %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetPlayerStatisticsTyped(ctx context.Context, req *GetPlayerStatisticsRequest) (*GetPlayerStatisticsResult, error) {
+	res := &GetPlayerStatisticsResult{}
+	if err := pf.call(ctx, "Server", "GetPlayerStatistics", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetPlayerCombinedInfoTyped(ctx context.Context, req *GetPlayerCombinedInfoRequest) (*GetPlayerCombinedInfoResult, error) {
+	res := &GetPlayerCombinedInfoResult{}
+	if err := pf.call(ctx, "Server", "GetPlayerCombinedInfo", req, res); err != nil {
+		return nil, err
+	}
+	if res.InfoResultPayload == nil {
+		return nil, fmt.Errorf("Failed to parse GetPlayerCombinedInfo result")
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) UpdatePlayerStatisticsTyped(ctx context.Context, req *UpdatePlayerStatisticsRequest) error {
+	return pf.call(ctx, "Server", "UpdatePlayerStatistics", req, nil)
+}
+
+func (pf *PlayFab) GetTitleInternalDataTyped(ctx context.Context, req *GetTitleDataRequest) (*GetTitleDataResult, error) {
+	res := &GetTitleDataResult{}
+	if err := pf.call(ctx, "Server", "GetTitleInternalData", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetTitleDataTyped(ctx context.Context, req *GetTitleDataRequest) (*GetTitleDataResult, error) {
+	res := &GetTitleDataResult{}
+	if err := pf.call(ctx, "Server", "GetTitleData", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetStoreItemsTyped(ctx context.Context, req *GetStoreItemsRequest) (*GetStoreItemsResult, error) {
+	r := *req
+	r.CatalogVersion = pf.catalog(r.CatalogVersion)
+	res := &GetStoreItemsResult{}
+	if err := pf.call(ctx, "Server", "GetStoreItems", &r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetStoreTyped(ctx context.Context, req *GetStoreItemsRequest) (*StoreMarketingModel, error) {
+	res, err := pf.GetStoreItemsTyped(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if res.MarketingData == nil {
+		return nil, fmt.Errorf("Failed to parse MarketingData ")
+	}
+	return res.MarketingData, nil
+}
+
+func (pf *PlayFab) GetCatalogItemsTyped(ctx context.Context, req *GetCatalogItemsRequest) (*GetCatalogItemsResult, error) {
+	r := *req
+	r.CatalogVersion = pf.catalog(r.CatalogVersion)
+	res := &GetCatalogItemsResult{}
+	if err := pf.call(ctx, "Server", "GetCatalogItems", &r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetUserInventoryTyped(ctx context.Context, req *GetUserInventoryRequest) (*GetUserInventoryResult, error) {
+	res := &GetUserInventoryResult{}
+	if err := pf.call(ctx, "Server", "GetUserInventory", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) GetVirtualCurrencyTyped(ctx context.Context, req *GetUserInventoryRequest) (*VirtualCurrencyBalances, error) {
+	return pf.GetVirtualCurrencyBalances(ctx, req.PlayFabId)
+}
+
+func (pf *PlayFab) AddUserVirtualCurrencyTyped(ctx context.Context, req *AddUserVirtualCurrencyRequest) (*ModifyUserVirtualCurrencyResult, error) {
+	res := &ModifyUserVirtualCurrencyResult{}
+	if err := pf.call(ctx, "Server", "AddUserVirtualCurrency", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) SubtractUserVirtualCurrencyTyped(ctx context.Context, req *SubtractUserVirtualCurrencyRequest) (*ModifyUserVirtualCurrencyResult, error) {
+	res := &ModifyUserVirtualCurrencyResult{}
+	if err := pf.call(ctx, "Server", "SubtractUserVirtualCurrency", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) ConsumeItemTyped(ctx context.Context, req *ConsumeItemRequest) (*ConsumeItemResult, error) {
+	res := &ConsumeItemResult{}
+	if err := pf.call(ctx, "Server", "ConsumeItem", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) RevokeInventoryItemsTyped(ctx context.Context, req *RevokeInventoryItemsRequest) (*RevokeInventoryItemsResult, error) {
+	// Nothing to revoke - do nothing
+	if len(req.Items) == 0 {
+		return &RevokeInventoryItemsResult{}, nil
+	}
+	res := &RevokeInventoryItemsResult{}
+	if err := pf.call(ctx, "Server", "RevokeInventoryItems", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (pf *PlayFab) SendPushNotificationTyped(ctx context.Context, req *SendPushNotificationRequest) error {
+	return pf.call(ctx, "Server", "SendPushNotification", req, nil)
+}
+
+func (pf *PlayFab) AddPlayerTagTyped(ctx context.Context, req *AddPlayerTagRequest) error {
+	return pf.call(ctx, "Server", "AddPlayerTag", req, nil)
+}
+
+func (pf *PlayFab) RemovePlayerTagTyped(ctx context.Context, req *RemovePlayerTagRequest) error {
+	return pf.call(ctx, "Server", "RemovePlayerTag", req, nil)
+}
+
+func (pf *PlayFab) GetPlayerTagsTyped(ctx context.Context, req *GetPlayerTagsRequest) (*GetPlayerTagsResult, error) {
+	res := &GetPlayerTagsResult{}
+	if err := pf.call(ctx, "Server", "GetPlayerTags", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
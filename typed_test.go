@@ -0,0 +1,182 @@
+package playfab_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+func TestTypedInventoryAndCurrency(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetCatalog("main", playfab.CatalogItem{ItemId: "sword", ItemClass: "weapon"})
+	srv.SetVirtualCurrency("player", "GO", 7)
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	grant, err := pf.GrantItemsToUserTyped(ctx, &playfab.GrantItemsToUserRequest{PlayFabId: "player", ItemIds: []string{"sword"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(grant.ItemGrantResults) != 1 || !grant.ItemGrantResults[0].Result || grant.ItemGrantResults[0].ItemClass != "weapon" {
+		t.Fatalf("got %+v", grant)
+	}
+
+	inv, err := pf.GetUserInventoryTyped(ctx, &playfab.GetUserInventoryRequest{PlayFabId: "player"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inv.Inventory) != 1 || inv.Inventory[0].ItemInstanceId != grant.ItemGrantResults[0].ItemInstanceId || inv.VirtualCurrency["GO"] != 7 {
+		t.Errorf("got %+v", inv)
+	}
+
+	balances, err := pf.GetVirtualCurrencyTyped(ctx, &playfab.GetUserInventoryRequest{PlayFabId: "player"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balances.VirtualCurrency["GO"] != 7 || balances.VirtualCurrencyRechargeTimes == nil {
+		t.Errorf("got %+v", balances)
+	}
+}
+
+func TestTypedStatistics(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetStatistic("player", "score", 10)
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	err := pf.UpdatePlayerStatisticsTyped(ctx, &playfab.UpdatePlayerStatisticsRequest{
+		PlayFabId:  "player",
+		Statistics: []playfab.StatisticUpdate{{StatisticName: "score", Value: 12}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := pf.GetPlayerStatisticsTyped(ctx, &playfab.GetPlayerStatisticsRequest{PlayFabId: "player"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Statistics) != 1 || res.Statistics[0].Value != 12 {
+		t.Errorf("got %+v", res.Statistics)
+	}
+}
+
+// sentCatalogVersion returns the CatalogVersion of the last funcName request
+// and whether it was sent at all.
+func sentCatalogVersion(t *testing.T, srv *playfabtest.Server, funcName string) (string, bool) {
+	t.Helper()
+	reqs := srv.Requests(funcName)
+	if len(reqs) == 0 {
+		t.Fatalf("%s was not called", funcName)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(reqs[len(reqs)-1], &body); err != nil {
+		t.Fatal(err)
+	}
+	v, ok := body["CatalogVersion"]
+	if !ok {
+		return "", false
+	}
+	s, _ := v.(string)
+	return s, true
+}
+
+func TestCatalogVersionOmittedWithoutDefault(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetCatalog("main", playfab.CatalogItem{ItemId: "sword"})
+	srv.SetRandomResultTable("drops", "sword")
+	srv.SetStore("main", "shop", playfabtest.Store{Items: []playfab.StoreItem{{ItemId: "sword"}}})
+	srv.AddPlayer("player")
+	pf, err := srv.NewClient("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// PlayFab falls back to the primary catalog when none is sent.
+	if items, err := pf.GetCatalogItemsCtx(ctx); err != nil || len(items) != 1 {
+		t.Errorf("GetCatalogItems: got %v, %v", items, err)
+	}
+	if _, err := pf.EvaluateRandomTableCtx(ctx, "drops", "player"); err != nil {
+		t.Errorf("EvaluateRandomTable: %v", err)
+	}
+	if _, err := pf.GrantItemsToUserCtx(ctx, []string{"sword"}, "player"); err != nil {
+		t.Errorf("GrantItemsToUser: %v", err)
+	}
+	if _, _, err := pf.GetStoreItemsCtx(ctx, "shop", "player"); err != nil {
+		t.Errorf("GetStoreItems: %v", err)
+	}
+	if _, err := pf.GetCatalogItemsTyped(ctx, &playfab.GetCatalogItemsRequest{}); err != nil {
+		t.Errorf("GetCatalogItemsTyped: %v", err)
+	}
+	for _, funcName := range []string{"GetCatalogItems", "EvaluateRandomResultTable", "GrantItemsToUser", "GetStoreItems"} {
+		for _, req := range srv.Requests(funcName) {
+			if bytes.Contains(req, []byte("CatalogVersion")) {
+				t.Errorf("%s sent %s", funcName, req)
+			}
+		}
+	}
+}
+
+func TestCatalogVersionPerCall(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetCatalog("main", playfab.CatalogItem{ItemId: "sword"})
+	srv.SetCatalog("winter", playfab.CatalogItem{ItemId: "sled"}, playfab.CatalogItem{ItemId: "skis"})
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	res, err := pf.GetCatalogItemsTyped(ctx, &playfab.GetCatalogItemsRequest{})
+	if err != nil || len(res.Catalog) != 1 {
+		t.Fatalf("got %+v, %v", res, err)
+	}
+	if v, _ := sentCatalogVersion(t, srv, "GetCatalogItems"); v != "main" {
+		t.Errorf("sent %q, want the client default", v)
+	}
+
+	res, err = pf.GetCatalogItemsTyped(ctx, &playfab.GetCatalogItemsRequest{CatalogVersion: "winter"})
+	if err != nil || len(res.Catalog) != 2 {
+		t.Fatalf("got %+v, %v", res, err)
+	}
+	if v, _ := sentCatalogVersion(t, srv, "GetCatalogItems"); v != "winter" {
+		t.Errorf("sent %q, want the request's version", v)
+	}
+}
+
+func TestForCatalogVersion(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetCatalog("main", playfab.CatalogItem{ItemId: "sword"})
+	srv.SetCatalog("winter", playfab.CatalogItem{ItemId: "sled"})
+	srv.AddPlayer("player")
+	pf, _ := srv.NewClient("main")
+	winter := pf.ForCatalogVersion("winter")
+	ctx := context.Background()
+
+	if _, err := winter.GrantItemsToUserCtx(ctx, []string{"sled"}, "player"); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := sentCatalogVersion(t, srv, "GrantItemsToUser"); v != "winter" {
+		t.Errorf("sent %q, want winter", v)
+	}
+	if _, err := winter.GetCatalogItemsTyped(ctx, &playfab.GetCatalogItemsRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := sentCatalogVersion(t, srv, "GetCatalogItems"); v != "winter" {
+		t.Errorf("sent %q, want winter", v)
+	}
+
+	// The original client keeps its own default.
+	if _, err := pf.GrantItemsToUserCtx(ctx, []string{"sword"}, "player"); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := sentCatalogVersion(t, srv, "GrantItemsToUser"); v != "main" {
+		t.Errorf("sent %q, want main", v)
+	}
+}
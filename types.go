@@ -0,0 +1,431 @@
+package playfab
+
+import "time"
+
+// The types below mirror the request and result models of the PlayFab Server
+// API. Field names follow the PlayFab schema so they encode without tags.
+
+type UserDataPermission string
+
+const (
+	UserDataPermissionPrivate UserDataPermission = "Private"
+	UserDataPermissionPublic  UserDataPermission = "Public"
+)
+
+type CatalogItemConsumableInfo struct {
+	UsageCount       *uint32 `json:",omitempty"`
+	UsagePeriod      *uint32 `json:",omitempty"`
+	UsagePeriodGroup string  `json:",omitempty"`
+}
+
+type CatalogItemContainerInfo struct {
+	KeyItemId               string            `json:",omitempty"`
+	ItemContents            []string          `json:",omitempty"`
+	ResultTableContents     []string          `json:",omitempty"`
+	VirtualCurrencyContents map[string]uint32 `json:",omitempty"`
+}
+
+type CatalogItemBundleInfo struct {
+	BundledItems             []string          `json:",omitempty"`
+	BundledResultTables      []string          `json:",omitempty"`
+	BundledVirtualCurrencies map[string]uint32 `json:",omitempty"`
+}
+
+type CatalogItem struct {
+	ItemId                     string
+	ItemClass                  string                     `json:",omitempty"`
+	CatalogVersion             string                     `json:",omitempty"`
+	DisplayName                string                     `json:",omitempty"`
+	Description                string                     `json:",omitempty"`
+	VirtualCurrencyPrices      map[string]uint32          `json:",omitempty"`
+	RealCurrencyPrices         map[string]uint32          `json:",omitempty"`
+	Tags                       []string                   `json:",omitempty"`
+	CustomData                 string                     `json:",omitempty"`
+	Consumable                 *CatalogItemConsumableInfo `json:",omitempty"`
+	Container                  *CatalogItemContainerInfo  `json:",omitempty"`
+	Bundle                     *CatalogItemBundleInfo     `json:",omitempty"`
+	CanBecomeCharacter         bool
+	IsStackable                bool
+	IsTradable                 bool
+	ItemImageUrl               string `json:",omitempty"`
+	IsLimitedEdition           bool
+	InitialLimitedEditionCount int32
+}
+
+type ItemInstance struct {
+	ItemId            string
+	ItemInstanceId    string
+	ItemClass         string            `json:",omitempty"`
+	PurchaseDate      *time.Time        `json:",omitempty"`
+	Expiration        *time.Time        `json:",omitempty"`
+	RemainingUses     *int32            `json:",omitempty"`
+	UsesIncrementedBy *int32            `json:",omitempty"`
+	Annotation        string            `json:",omitempty"`
+	CatalogVersion    string            `json:",omitempty"`
+	BundleParent      string            `json:",omitempty"`
+	DisplayName       string            `json:",omitempty"`
+	UnitCurrency      string            `json:",omitempty"`
+	UnitPrice         uint32            `json:",omitempty"`
+	BundleContents    []string          `json:",omitempty"`
+	CustomData        map[string]string `json:",omitempty"`
+}
+
+type GrantedItemInstance struct {
+	ItemInstance
+	PlayFabId   string `json:",omitempty"`
+	CharacterId string `json:",omitempty"`
+	Result      bool
+}
+
+type StoreItem struct {
+	ItemId                string
+	VirtualCurrencyPrices map[string]uint32 `json:",omitempty"`
+	RealCurrencyPrices    map[string]uint32 `json:",omitempty"`
+	CustomData            interface{}       `json:",omitempty"`
+	DisplayPosition       *uint32           `json:",omitempty"`
+}
+
+type StoreMarketingModel struct {
+	DisplayName string      `json:",omitempty"`
+	Description string      `json:",omitempty"`
+	Metadata    interface{} `json:",omitempty"`
+}
+
+type VirtualCurrencyRechargeTime struct {
+	SecondsToRecharge int32
+	RechargeTime      time.Time
+	RechargeMax       int32
+}
+
+type UserDataRecord struct {
+	Value       string
+	LastUpdated time.Time
+	Permission  UserDataPermission `json:",omitempty"`
+}
+
+type StatisticValue struct {
+	StatisticName string
+	Value         int32
+	Version       uint32
+}
+
+type StatisticUpdate struct {
+	StatisticName string
+	Value         int32
+	Version       *uint32 `json:",omitempty"`
+}
+
+type StatisticNameVersion struct {
+	StatisticName string
+	Version       uint32
+}
+
+type TagModel struct {
+	TagValue string
+}
+
+type StatisticModel struct {
+	Name    string
+	Value   int32
+	Version int32
+}
+
+type PlayerProfileViewConstraints struct {
+	ShowAvatarUrl                     bool
+	ShowBannedUntil                   bool
+	ShowCampaignAttributions          bool
+	ShowContactEmailAddresses         bool
+	ShowCreated                       bool
+	ShowDisplayName                   bool
+	ShowExperimentVariants            bool
+	ShowLastLogin                     bool
+	ShowLinkedAccounts                bool
+	ShowLocations                     bool
+	ShowMemberships                   bool
+	ShowOrigination                   bool
+	ShowPushNotificationRegistrations bool
+	ShowStatistics                    bool
+	ShowTags                          bool
+	ShowTotalValueToDateInUsd         bool
+	ShowValuesToDate                  bool
+}
+
+type PlayerProfileModel struct {
+	PlayerId    string
+	TitleId     string           `json:",omitempty"`
+	PublisherId string           `json:",omitempty"`
+	DisplayName string           `json:",omitempty"`
+	AvatarUrl   string           `json:",omitempty"`
+	Origination string           `json:",omitempty"`
+	Created     *time.Time       `json:",omitempty"`
+	LastLogin   *time.Time       `json:",omitempty"`
+	BannedUntil *time.Time       `json:",omitempty"`
+	Tags        []TagModel       `json:",omitempty"`
+	Statistics  []StatisticModel `json:",omitempty"`
+}
+
+type UserTitleInfo struct {
+	DisplayName string `json:",omitempty"`
+	Origination string `json:",omitempty"`
+	AvatarUrl   string `json:",omitempty"`
+	Created     time.Time
+	FirstLogin  *time.Time `json:",omitempty"`
+	LastLogin   *time.Time `json:",omitempty"`
+	IsBanned    bool       `json:"isBanned"`
+}
+
+type UserAccountInfo struct {
+	PlayFabId string
+	Created   time.Time
+	Username  string         `json:",omitempty"`
+	TitleInfo *UserTitleInfo `json:",omitempty"`
+}
+
+type CharacterResult struct {
+	CharacterId   string
+	CharacterName string `json:",omitempty"`
+	CharacterType string `json:",omitempty"`
+}
+
+type CharacterInventory struct {
+	CharacterId string
+	Inventory   []ItemInstance
+}
+
+type GetPlayerCombinedInfoRequestParams struct {
+	GetCharacterInventories bool
+	GetCharacterList        bool
+	GetPlayerProfile        bool
+	GetPlayerStatistics     bool
+	GetTitleData            bool
+	GetUserAccountInfo      bool
+	GetUserData             bool
+	GetUserInventory        bool
+	GetUserReadOnlyData     bool
+	GetUserVirtualCurrency  bool
+	PlayerStatisticNames    []string                      `json:",omitempty"`
+	TitleDataKeys           []string                      `json:",omitempty"`
+	UserDataKeys            []string                      `json:",omitempty"`
+	UserReadOnlyDataKeys    []string                      `json:",omitempty"`
+	ProfileConstraints      *PlayerProfileViewConstraints `json:",omitempty"`
+}
+
+type GetPlayerCombinedInfoResultPayload struct {
+	AccountInfo                      *UserAccountInfo                       `json:",omitempty"`
+	CharacterInventories             []CharacterInventory                   `json:",omitempty"`
+	CharacterList                    []CharacterResult                      `json:",omitempty"`
+	PlayerProfile                    *PlayerProfileModel                    `json:",omitempty"`
+	PlayerStatistics                 []StatisticValue                       `json:",omitempty"`
+	TitleData                        map[string]string                      `json:",omitempty"`
+	UserData                         map[string]UserDataRecord              `json:",omitempty"`
+	UserDataVersion                  uint32                                 `json:",omitempty"`
+	UserInventory                    []ItemInstance                         `json:",omitempty"`
+	UserReadOnlyData                 map[string]UserDataRecord              `json:",omitempty"`
+	UserReadOnlyDataVersion          uint32                                 `json:",omitempty"`
+	UserVirtualCurrency              map[string]int32                       `json:",omitempty"`
+	UserVirtualCurrencyRechargeTimes map[string]VirtualCurrencyRechargeTime `json:",omitempty"`
+}
+
+type EvaluateRandomResultTableRequest struct {
+	TableId        string
+	CatalogVersion string `json:",omitempty"`
+}
+
+type EvaluateRandomResultTableResult struct {
+	ResultItemId string
+}
+
+type GetUserDataRequest struct {
+	PlayFabId                string
+	Keys                     []string `json:",omitempty"`
+	IfChangedFromDataVersion *uint32  `json:",omitempty"`
+}
+
+type GetUserDataResult struct {
+	PlayFabId   string
+	DataVersion uint32
+	Data        map[string]UserDataRecord
+}
+
+type UpdateUserDataRequest struct {
+	PlayFabId    string
+	Data         map[string]string  `json:",omitempty"`
+	KeysToRemove []string           `json:",omitempty"`
+	Permission   UserDataPermission `json:",omitempty"`
+}
+
+type UpdateUserInternalDataRequest struct {
+	PlayFabId    string
+	Data         map[string]string `json:",omitempty"`
+	KeysToRemove []string          `json:",omitempty"`
+}
+
+type UpdateUserDataResult struct {
+	DataVersion uint32
+}
+
+type GrantItemsToUserRequest struct {
+	PlayFabId      string
+	ItemIds        []string
+	CatalogVersion string `json:",omitempty"`
+	Annotation     string `json:",omitempty"`
+}
+
+type GrantItemsToUserResult struct {
+	ItemGrantResults []GrantedItemInstance
+}
+
+type GetPlayerStatisticsRequest struct {
+	PlayFabId             string
+	StatisticNames        []string               `json:",omitempty"`
+	StatisticNameVersions []StatisticNameVersion `json:",omitempty"`
+}
+
+type GetPlayerStatisticsResult struct {
+	PlayFabId  string
+	Statistics []StatisticValue
+}
+
+type UpdatePlayerStatisticsRequest struct {
+	PlayFabId   string
+	Statistics  []StatisticUpdate
+	ForceUpdate bool `json:",omitempty"`
+}
+
+type GetPlayerCombinedInfoRequest struct {
+	PlayFabId             string
+	InfoRequestParameters GetPlayerCombinedInfoRequestParams
+}
+
+type GetPlayerCombinedInfoResult struct {
+	PlayFabId         string
+	InfoResultPayload *GetPlayerCombinedInfoResultPayload
+}
+
+type GetTitleDataRequest struct {
+	Keys          []string `json:",omitempty"`
+	OverrideLabel string   `json:",omitempty"`
+}
+
+type GetTitleDataResult struct {
+	Data map[string]string
+}
+
+type GetStoreItemsRequest struct {
+	StoreId        string
+	CatalogVersion string `json:",omitempty"`
+	PlayFabId      string `json:",omitempty"`
+}
+
+type GetStoreItemsResult struct {
+	StoreId        string
+	CatalogVersion string
+	Store          []StoreItem
+	MarketingData  *StoreMarketingModel
+	Source         string
+}
+
+type GetCatalogItemsRequest struct {
+	CatalogVersion string `json:",omitempty"`
+}
+
+type GetCatalogItemsResult struct {
+	Catalog []CatalogItem
+}
+
+type GetUserInventoryRequest struct {
+	PlayFabId string
+}
+
+type GetUserInventoryResult struct {
+	PlayFabId                    string
+	Inventory                    []ItemInstance
+	VirtualCurrency              map[string]int32
+	VirtualCurrencyRechargeTimes map[string]VirtualCurrencyRechargeTime
+}
+
+type AddUserVirtualCurrencyRequest struct {
+	PlayFabId       string
+	VirtualCurrency string
+	Amount          int32
+}
+
+type SubtractUserVirtualCurrencyRequest struct {
+	PlayFabId       string
+	VirtualCurrency string
+	Amount          int32
+}
+
+type ModifyUserVirtualCurrencyResult struct {
+	PlayFabId       string
+	VirtualCurrency string
+	BalanceChange   int32
+	Balance         int32
+}
+
+type ConsumeItemRequest struct {
+	PlayFabId      string
+	ItemInstanceId string
+	ConsumeCount   int32
+	CharacterId    string `json:",omitempty"`
+}
+
+type ConsumeItemResult struct {
+	ItemInstanceId string
+	RemainingUses  int32
+}
+
+type RevokeInventoryItem struct {
+	PlayFabId      string
+	ItemInstanceId string
+	CharacterId    string `json:",omitempty"`
+}
+
+type RevokeInventoryItemsRequest struct {
+	Items []RevokeInventoryItem
+}
+
+type RevokeItemError struct {
+	Item  *RevokeInventoryItem
+	Error string
+}
+
+type RevokeInventoryItemsResult struct {
+	Errors []RevokeItemError
+}
+
+type PushNotificationPackage struct {
+	Title      string
+	Message    string
+	Icon       string `json:",omitempty"`
+	Sound      string `json:",omitempty"`
+	CustomData string `json:",omitempty"`
+}
+
+type SendPushNotificationRequest struct {
+	Recipient       string
+	Message         string                   `json:",omitempty"`
+	Subject         string                   `json:",omitempty"`
+	Package         *PushNotificationPackage `json:",omitempty"`
+	TargetPlatforms []string                 `json:",omitempty"`
+}
+
+type AddPlayerTagRequest struct {
+	PlayFabId string
+	TagName   string
+}
+
+type RemovePlayerTagRequest struct {
+	PlayFabId string
+	TagName   string
+}
+
+type GetPlayerTagsRequest struct {
+	PlayFabId string
+	Namespace string `json:",omitempty"`
+}
+
+type GetPlayerTagsResult struct {
+	PlayFabId string
+	Tags      []string
+}
@@ -0,0 +1,306 @@
+package playfab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// casMaxAttempts bounds how many times the *CAS helpers read, apply and
+// write before giving up with ErrDataVersionConflict. casBaseDelay is the
+// delay before the second attempt, doubled and jittered for every further one.
+const (
+	casMaxAttempts = 5
+	casBaseDelay   = 50 * time.Millisecond
+)
+
+// ErrDataVersionConflict is returned when the player's data changed after
+// the DataVersion a write was based on.
+var ErrDataVersionConflict = errors.New("playfab: user data changed since it was read")
+
+// UserDataInfo describes a user data value read with one of the Get*Value
+// helpers.
+type UserDataInfo struct {
+	LastUpdated time.Time
+	Permission  UserDataPermission
+	// DataVersion is the version of the player's data of that kind when the
+	// value was read.
+	DataVersion uint32
+}
+
+// Decode unmarshals the JSON held in the record into out.
+func (r UserDataRecord) Decode(out interface{}) error {
+	return json.Unmarshal([]byte(r.Value), out)
+}
+
+// GetUserReadOnlyValue decodes the JSON stored under key in the player's
+// read-only data into out. It returns nil, leaving out untouched, when the
+// key is not set.
+func (pf *PlayFab) GetUserReadOnlyValue(ctx context.Context, playFabId string, key string, out interface{}) (*UserDataInfo, error) {
+	res, err := pf.GetUserReadOnlyDataTyped(ctx, &GetUserDataRequest{PlayFabId: playFabId, Keys: []string{key}})
+	if err != nil {
+		return nil, err
+	}
+	return decodeUserData(res, key, out)
+}
+
+// SetUserReadOnlyValue stores v as JSON under key in the player's read-only
+// data.
+func (pf *PlayFab) SetUserReadOnlyValue(ctx context.Context, playFabId string, key string, v interface{}) error {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = pf.UpdateUserReadOnlyDataTyped(ctx, &UpdateUserDataRequest{
+		PlayFabId: playFabId,
+		Data:      map[string]string{key: string(value)},
+	})
+	return err
+}
+
+// GetUserInternalValue decodes the JSON stored under key in the player's
+// internal data into out. It returns nil, leaving out untouched, when the
+// key is not set.
+func (pf *PlayFab) GetUserInternalValue(ctx context.Context, playFabId string, key string, out interface{}) (*UserDataInfo, error) {
+	res, err := pf.GetUserInternalDataTyped(ctx, &GetUserDataRequest{PlayFabId: playFabId, Keys: []string{key}})
+	if err != nil {
+		return nil, err
+	}
+	return decodeUserData(res, key, out)
+}
+
+// SetUserInternalValue stores v as JSON under key in the player's internal
+// data.
+func (pf *PlayFab) SetUserInternalValue(ctx context.Context, playFabId string, key string, v interface{}) error {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = pf.UpdateUserInternalDataTyped(ctx, &UpdateUserInternalDataRequest{
+		PlayFabId: playFabId,
+		Data:      map[string]string{key: string(value)},
+	})
+	return err
+}
+
+func decodeUserData(res *GetUserDataResult, key string, out interface{}) (*UserDataInfo, error) {
+	r, ok := res.Data[key]
+	if !ok {
+		return nil, nil
+	}
+	info := &UserDataInfo{
+		LastUpdated: r.LastUpdated,
+		Permission:  r.Permission,
+		DataVersion: res.DataVersion,
+	}
+	if err := r.Decode(out); err != nil {
+		return info, err
+	}
+	return info, nil
+}
+
+// UpdateUserReadOnlyDataIfVersion applies req only if the player's read-only
+// data is still at version. PlayFab has no conditional writes, so the version
+// is checked right before the write and again from its result; in the latter
+// case the write has been made and ErrDataVersionConflict tells the caller it
+// may have raced with another writer. The *IfVersion and *CAS helpers of a
+// client are serialized per player; plain writes such as UpdateUserData and
+// writers in other processes are not, and can race with them.
+func (pf *PlayFab) UpdateUserReadOnlyDataIfVersion(ctx context.Context, req *UpdateUserDataRequest, version uint32) (*UpdateUserDataResult, error) {
+	return pf.lockedUpdateIfVersion(ctx, UserDataKindReadOnly, req, version)
+}
+
+// UpdateUserInternalDataIfVersion is UpdateUserReadOnlyDataIfVersion for the
+// player's internal data.
+func (pf *PlayFab) UpdateUserInternalDataIfVersion(ctx context.Context, req *UpdateUserInternalDataRequest, version uint32) (*UpdateUserDataResult, error) {
+	return pf.lockedUpdateIfVersion(ctx, UserDataKindInternal, &UpdateUserDataRequest{
+		PlayFabId:    req.PlayFabId,
+		Data:         req.Data,
+		KeysToRemove: req.KeysToRemove,
+	}, version)
+}
+
+// UpdateUserReadOnlyDataCAS reads keys of the player's read-only data, all of
+// them when keys is empty, and writes what fn returns for them if the data
+// did not change in between. Keys fn leaves out of next are removed. When
+// the data changed before the write fn is called again with the new values,
+// after a short jittered delay.
+//
+// This is not a true compare-and-swap: PlayFab has no conditional writes.
+// The *IfVersion and *CAS helpers of a client are serialized per player, but
+// a plain write or a writer in another process that slips in between the
+// version check and the write is overwritten. The lost update is then
+// reported as ErrDataVersionConflict once the write has been made.
+//
+// fn runs with the player's lock held. It must not call the *IfVersion or
+// *CAS helpers for the same player: the lock is not reentrant and the call
+// deadlocks.
+func (pf *PlayFab) UpdateUserReadOnlyDataCAS(ctx context.Context, playFabId string, keys []string, fn func(current map[string]string) (map[string]string, error)) error {
+	return pf.UpdateUserDataRecordsCAS(ctx, UserDataKindReadOnly, playFabId, keys, fn)
+}
+
+// UpdateUserInternalDataCAS is UpdateUserReadOnlyDataCAS for the player's
+// internal data.
+func (pf *PlayFab) UpdateUserInternalDataCAS(ctx context.Context, playFabId string, keys []string, fn func(current map[string]string) (map[string]string, error)) error {
+	return pf.UpdateUserDataRecordsCAS(ctx, UserDataKindInternal, playFabId, keys, fn)
+}
+
+// UpdateUserDataIfVersion is UpdateUserReadOnlyDataIfVersion for the
+// player's own data.
+func (pf *PlayFab) UpdateUserDataIfVersion(ctx context.Context, req *UpdateUserDataRequest, version uint32) (*UpdateUserDataResult, error) {
+	return pf.lockedUpdateIfVersion(ctx, UserDataKindPlayer, req, version)
+}
+
+// UpdateUserDataCAS is UpdateUserReadOnlyDataCAS for the player's own data.
+func (pf *PlayFab) UpdateUserDataCAS(ctx context.Context, playFabId string, keys []string, fn func(current map[string]string) (map[string]string, error)) error {
+	return pf.UpdateUserDataRecordsCAS(ctx, UserDataKindPlayer, playFabId, keys, fn)
+}
+
+// UpdateUserDataRecordsCAS is UpdateUserReadOnlyDataCAS for any kind of
+// player data.
+func (pf *PlayFab) UpdateUserDataRecordsCAS(ctx context.Context, kind UserDataKind, playFabId string, keys []string, fn func(current map[string]string) (map[string]string, error)) error {
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(casBackoff(attempt - 1)):
+			}
+		}
+		retry, err := pf.casAttempt(ctx, kind, playFabId, keys, fn)
+		if !retry || attempt >= casMaxAttempts {
+			return err
+		}
+	}
+}
+
+// casAttempt makes one read, apply and write round of
+// UpdateUserDataRecordsCAS and reports whether it may be retried.
+func (pf *PlayFab) casAttempt(ctx context.Context, kind UserDataKind, playFabId string, keys []string, fn func(current map[string]string) (map[string]string, error)) (bool, error) {
+	unlock := pf.dataLocks.lock(playFabId)
+	defer unlock()
+
+	cur, err := pf.GetUserDataRecords(ctx, kind, &GetUserDataRequest{PlayFabId: playFabId, Keys: keys})
+	if err != nil {
+		return false, err
+	}
+	current := make(map[string]string, len(cur.Data))
+	for k, r := range cur.Data {
+		current[k] = r.Value
+	}
+	next, err := fn(copyValues(current))
+	if err != nil {
+		return false, err
+	}
+
+	req := &UpdateUserDataRequest{PlayFabId: playFabId, Data: make(map[string]string)}
+	for k, v := range next {
+		if old, ok := current[k]; !ok || old != v {
+			req.Data[k] = v
+		}
+	}
+	for k := range current {
+		if _, ok := next[k]; !ok {
+			req.KeysToRemove = append(req.KeysToRemove, k)
+		}
+	}
+	if len(req.Data) == 0 && len(req.KeysToRemove) == 0 {
+		return false, nil
+	}
+
+	// A conflict found after the write can't be retried: the write was
+	// made and fn is not applied twice.
+	res, err := pf.updateIfVersion(ctx, kind, req, cur.DataVersion)
+	return err == ErrDataVersionConflict && res == nil, err
+}
+
+func (pf *PlayFab) lockedUpdateIfVersion(ctx context.Context, kind UserDataKind, req *UpdateUserDataRequest, version uint32) (*UpdateUserDataResult, error) {
+	unlock := pf.dataLocks.lock(req.PlayFabId)
+	defer unlock()
+	return pf.updateIfVersion(ctx, kind, req, version)
+}
+
+// updateIfVersion must be called with the player's data lock held.
+func (pf *PlayFab) updateIfVersion(ctx context.Context, kind UserDataKind, req *UpdateUserDataRequest, version uint32) (*UpdateUserDataResult, error) {
+	// Only the written keys are asked for, so a moved version doesn't
+	// download the player's whole data just to read DataVersion.
+	keys := make([]string, 0, len(req.Data)+len(req.KeysToRemove))
+	for k := range req.Data {
+		keys = append(keys, k)
+	}
+	keys = append(keys, req.KeysToRemove...)
+	cur, err := pf.GetUserDataRecords(ctx, kind, &GetUserDataRequest{
+		PlayFabId:                req.PlayFabId,
+		Keys:                     keys,
+		IfChangedFromDataVersion: &version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cur.DataVersion != version {
+		return nil, ErrDataVersionConflict
+	}
+	res, err := pf.UpdateUserDataRecords(ctx, kind, req)
+	if err != nil {
+		return nil, err
+	}
+	if res.DataVersion != version+1 {
+		return res, ErrDataVersionConflict
+	}
+	return res, nil
+}
+
+// casBackoff returns the delay before the given retry of a *CAS helper.
+func casBackoff(retry int) time.Duration {
+	delay := casBaseDelay << uint(retry-1)
+	return delay/2 + time.Duration(rand.Int63n(int64(delay)))
+}
+
+// keyedMutex hands out one mutex per key, dropping it once nobody holds or
+// waits for it.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: make(map[string]*keyLock)}
+}
+
+// lock locks key and returns the function that unlocks it.
+func (m *keyedMutex) lock(key string) func() {
+	m.mu.Lock()
+	l, ok := m.locks[key]
+	if !ok {
+		l = &keyLock{}
+		m.locks[key] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		m.mu.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(m.locks, key)
+		}
+		m.mu.Unlock()
+	}
+}
+
+func copyValues(values map[string]string) map[string]string {
+	res := make(map[string]string, len(values))
+	for k, v := range values {
+		res[k] = v
+	}
+	return res
+}
@@ -0,0 +1,208 @@
+package playfab_test
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Innplay-Labs/playfab-go/v2"
+	"github.com/Innplay-Labs/playfab-go/v2/playfabtest"
+)
+
+type progress struct {
+	Level int
+	Boss  string
+}
+
+func TestUserReadOnlyValue(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.AddPlayer("player")
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+	before := time.Now().Add(-time.Second)
+
+	if err := pf.SetUserReadOnlyValue(ctx, "player", "progress", progress{Level: 4, Boss: "ogre"}); err != nil {
+		t.Fatal(err)
+	}
+	var got progress
+	info, err := pf.GetUserReadOnlyValue(ctx, "player", "progress", &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Level != 4 || got.Boss != "ogre" {
+		t.Errorf("got %+v", got)
+	}
+	if info == nil || info.DataVersion != 1 || info.Permission != playfab.UserDataPermissionPrivate || info.LastUpdated.Before(before) {
+		t.Errorf("got %+v, want the record's version, permission and update time", info)
+	}
+
+	info, err = pf.GetUserReadOnlyValue(ctx, "player", "missing", &got)
+	if info != nil || err != nil {
+		t.Errorf("got %+v, %v for a missing key", info, err)
+	}
+}
+
+func TestUserInternalValue(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetUserInternalData("player", map[string]string{"progress": "not json"})
+	pf, _ := srv.NewClient("main")
+	ctx := context.Background()
+
+	var got progress
+	if info, err := pf.GetUserInternalValue(ctx, "player", "progress", &got); info == nil || err == nil {
+		t.Errorf("got %+v, %v, want the record and a decode error", info, err)
+	}
+	if err := pf.SetUserInternalValue(ctx, "player", "progress", progress{Level: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if v := srv.UserInternalData("player")["progress"]; v != `{"Level":2,"Boss":""}` {
+		t.Errorf("stored %s", v)
+	}
+}
+
+func TestTitleInternalDataValue(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetTitleInternalData(map[string]string{"limits": "[1,2]"})
+	pf, _ := srv.NewClient("main")
+
+	var limits []int
+	ok, err := pf.GetTitleInternalDataValue(context.Background(), "limits", &limits)
+	if err != nil || !ok {
+		t.Fatalf("got %v, %v", ok, err)
+	}
+	if len(limits) != 2 || limits[1] != 2 {
+		t.Errorf("got %v", limits)
+	}
+}
+
+func increment(current map[string]string) (map[string]string, error) {
+	n, _ := strconv.Atoi(current["n"])
+	current["n"] = strconv.Itoa(n + 1)
+	return current, nil
+}
+
+func TestUpdateUserDataCAS(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetUserReadOnlyData("player", map[string]string{"n": "1", "other": "x"})
+	pf, _ := srv.NewClient("main")
+
+	if err := pf.UpdateUserReadOnlyDataCAS(context.Background(), "player", []string{"n"}, increment); err != nil {
+		t.Fatal(err)
+	}
+	data := srv.UserReadOnlyData("player")
+	if data["n"] != "2" || data["other"] != "x" {
+		t.Errorf("got %v", data)
+	}
+}
+
+func TestUpdateUserDataCASRetriesAfterConcurrentWrite(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetUserReadOnlyData("player", map[string]string{"n": "1"})
+	pf, _ := srv.NewClient("main")
+
+	calls := 0
+	err := pf.UpdateUserReadOnlyDataCAS(context.Background(), "player", []string{"n"}, func(current map[string]string) (map[string]string, error) {
+		calls++
+		if calls == 1 {
+			// Another process writes between the read and the write.
+			srv.SetUserReadOnlyData("player", map[string]string{"n": "10"})
+		}
+		return increment(current)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("fn was called %d times, want 2", calls)
+	}
+	if n := srv.UserReadOnlyData("player")["n"]; n != "11" {
+		t.Errorf("n is %s, want 11", n)
+	}
+}
+
+func TestUpdateUserDataCASConcurrentWriters(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetUserInternalData("player", map[string]string{"n": "0"})
+	pf, _ := srv.NewClient("main")
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- pf.UpdateUserInternalDataCAS(context.Background(), "player", []string{"n"}, increment)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if n := srv.UserInternalData("player")["n"]; n != "20" {
+		t.Errorf("n is %s, want 20", n)
+	}
+}
+
+func TestUpdateUserDataIfVersion(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetUserReadOnlyData("player", map[string]string{"n": "1"})
+	pf, _ := srv.NewClient("main")
+
+	cur, err := pf.GetUserReadOnlyDataTyped(context.Background(), &playfab.GetUserDataRequest{PlayFabId: "player"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &playfab.UpdateUserDataRequest{PlayFabId: "player", Data: map[string]string{"n": "2"}}
+
+	_, err = pf.UpdateUserReadOnlyDataIfVersion(context.Background(), req, cur.DataVersion-1)
+	if err != playfab.ErrDataVersionConflict {
+		t.Errorf("got %v, want ErrDataVersionConflict", err)
+	}
+	if srv.Calls("UpdateUserReadOnlyData") != 0 {
+		t.Error("data was written at a stale version")
+	}
+
+	res, err := pf.UpdateUserReadOnlyDataIfVersion(context.Background(), req, cur.DataVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.DataVersion != cur.DataVersion+1 || srv.UserReadOnlyData("player")["n"] != "2" {
+		t.Errorf("got version %d and data %v", res.DataVersion, srv.UserReadOnlyData("player"))
+	}
+}
+
+func TestUpdateUserDataIfVersionReadsWrittenKeys(t *testing.T) {
+	srv := playfabtest.NewServer()
+	defer srv.Close()
+	srv.SetUserReadOnlyData("player", map[string]string{"n": "1", "big": "x"})
+	pf, _ := srv.NewClient("main")
+
+	req := &playfab.UpdateUserDataRequest{PlayFabId: "player", Data: map[string]string{"n": "2"}, KeysToRemove: []string{"old"}}
+	if _, err := pf.UpdateUserReadOnlyDataIfVersion(context.Background(), req, 1); err != nil {
+		t.Fatal(err)
+	}
+	reqs := srv.Requests("GetUserReadOnlyData")
+	if len(reqs) != 1 {
+		t.Fatalf("got %d reads, want 1", len(reqs))
+	}
+	var read playfab.GetUserDataRequest
+	if err := json.Unmarshal(reqs[0], &read); err != nil {
+		t.Fatal(err)
+	}
+	if len(read.Keys) != 2 || read.Keys[0] != "n" || read.Keys[1] != "old" {
+		t.Errorf("read keys %v, want n and old", read.Keys)
+	}
+}